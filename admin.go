@@ -0,0 +1,323 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Admin performs administration of tables and their column families.
+// Gateways which don't support administration RPCs return error matching ErrUnsupportedOperation.
+// Methods without context are executed with call timeout of the connection.
+type Admin struct {
+	connection *Connection
+}
+
+// ColumnFamily parameters of column family which stores the subtree of documents rooted at JsonPath
+// Name name of the family
+// JsonPath OJAI FieldPath of the family root, empty for the default family
+// TTL time to live of the family data with one second precision, data never expires if zero
+// InMemory whether the family data should be kept in memory
+type ColumnFamily struct {
+	Name     string
+	JsonPath string
+	TTL      time.Duration
+	InMemory bool
+}
+
+// TableDescriptor parameters of JSON table
+// Path path of the table
+// AutoSplit whether regions of the table are split automatically when they grow
+// SplitSize size of region in MB at which it is split, server default is used if zero
+// BulkLoad whether the table is in bulk load mode
+// ColumnFamilies column families of the table, the default family may be specified to set its parameters
+type TableDescriptor struct {
+	Path           string
+	AutoSplit      bool
+	SplitSize      int64
+	BulkLoad       bool
+	ColumnFamilies []ColumnFamily
+}
+
+// MakeTableDescriptor returns descriptor of table with auto split and without additional column families
+func MakeTableDescriptor(path string) *TableDescriptor {
+	return &TableDescriptor{Path: path, AutoSplit: true}
+}
+
+// Admin method returns administration API of the connection
+func (connection *Connection) Admin() *Admin {
+	return &Admin{connection: connection}
+}
+
+// callContext method returns ctx or context with call timeout of the connection if ctx is nil
+func (admin *Admin) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx != nil {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(context.Background(), admin.connection.callTimeout)
+}
+
+// CreateTable method creates table with parameters of descriptor and returns DocumentStore of the new table.
+func (admin *Admin) CreateTable(descriptor *TableDescriptor) (*DocumentStore, error) {
+	return admin.CreateTableWithContext(descriptor, nil)
+}
+
+// CreateTableWithContext method creates table with parameters of descriptor and returns DocumentStore of the new table.
+// User defined context is required for this method.
+func (admin *Admin) CreateTableWithContext(descriptor *TableDescriptor, ctx context.Context) (*DocumentStore, error) {
+	request, err := descriptor.toProto()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.CreateTableWithDescriptor(ctx,
+		&CreateTableWithDescriptorRequest{TableSpec: request})
+	if err != nil {
+		return nil, wrapRpcError(err)
+	}
+	if err = checkResponseErrorCode(response.GetError()); err != nil {
+		return nil, err
+	}
+	return &DocumentStore{connection: admin.connection, storeName: descriptor.Path}, nil
+}
+
+// DeleteTable method deletes the table.
+func (admin *Admin) DeleteTable(tablePath string) error {
+	return admin.DeleteTableWithContext(tablePath, nil)
+}
+
+// DeleteTableWithContext method deletes the table.
+// User defined context is required for this method.
+func (admin *Admin) DeleteTableWithContext(tablePath string, ctx context.Context) error {
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.DeleteTable(ctx, &DeleteTableRequest{TablePath: tablePath})
+	if err != nil {
+		return wrapRpcError(err)
+	}
+	return checkResponseErrorCode(response.GetError())
+}
+
+// TableExists method returns true if the table exists.
+func (admin *Admin) TableExists(tablePath string) (bool, error) {
+	return admin.TableExistsWithContext(tablePath, nil)
+}
+
+// TableExistsWithContext method returns true if the table exists.
+// User defined context is required for this method.
+func (admin *Admin) TableExistsWithContext(tablePath string, ctx context.Context) (bool, error) {
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.TableExists(ctx, &TableExistsRequest{TablePath: tablePath})
+	if err != nil {
+		return false, wrapRpcError(err)
+	}
+	return checkExistsErrorCode(response.GetError())
+}
+
+// ListTables method returns paths of tables in the directory.
+func (admin *Admin) ListTables(path string) ([]string, error) {
+	return admin.ListTablesWithContext(path, nil)
+}
+
+// ListTablesWithContext method returns paths of tables in the directory.
+// User defined context is required for this method.
+func (admin *Admin) ListTablesWithContext(path string, ctx context.Context) ([]string, error) {
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.ListTables(ctx, &ListTablesRequest{Path: path})
+	if err != nil {
+		return nil, wrapRpcError(err)
+	}
+	if err = checkResponseErrorCode(response.GetError()); err != nil {
+		return nil, err
+	}
+	return response.GetTablePaths(), nil
+}
+
+// DescribeTable method returns parameters and column families of the table.
+func (admin *Admin) DescribeTable(tablePath string) (*TableDescriptor, error) {
+	return admin.DescribeTableWithContext(tablePath, nil)
+}
+
+// DescribeTableWithContext method returns parameters and column families of the table.
+// User defined context is required for this method.
+func (admin *Admin) DescribeTableWithContext(tablePath string, ctx context.Context) (*TableDescriptor, error) {
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.DescribeTable(ctx, &DescribeTableRequest{TablePath: tablePath})
+	if err != nil {
+		return nil, wrapRpcError(err)
+	}
+	if err = checkResponseErrorCode(response.GetError()); err != nil {
+		return nil, err
+	}
+	return tableDescriptorFromProto(response.GetTableSpec()), nil
+}
+
+// AlterTable method changes auto split, split size and bulk load mode of the table,
+// column families of descriptor are ignored.
+func (admin *Admin) AlterTable(descriptor *TableDescriptor) error {
+	return admin.AlterTableWithContext(descriptor, nil)
+}
+
+// AlterTableWithContext method changes auto split, split size and bulk load mode of the table,
+// column families of descriptor are ignored.
+// User defined context is required for this method.
+func (admin *Admin) AlterTableWithContext(descriptor *TableDescriptor, ctx context.Context) error {
+	request, err := descriptor.toProto()
+	if err != nil {
+		return err
+	}
+	request.ColumnFamilies = nil
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.AlterTable(ctx, &AlterTableRequest{TableSpec: request})
+	if err != nil {
+		return wrapRpcError(err)
+	}
+	return checkResponseErrorCode(response.GetError())
+}
+
+// AddColumnFamily method adds column family to the table.
+func (admin *Admin) AddColumnFamily(tablePath string, family ColumnFamily) error {
+	return admin.AddColumnFamilyWithContext(tablePath, family, nil)
+}
+
+// AddColumnFamilyWithContext method adds column family to the table.
+// User defined context is required for this method.
+func (admin *Admin) AddColumnFamilyWithContext(tablePath string, family ColumnFamily, ctx context.Context) error {
+	request, err := family.toProto()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.AddColumnFamily(ctx,
+		&AddColumnFamilyRequest{TablePath: tablePath, ColumnFamily: request})
+	if err != nil {
+		return wrapRpcError(err)
+	}
+	return checkResponseErrorCode(response.GetError())
+}
+
+// AlterColumnFamily method changes TTL and in-memory flag of the column family with the same name,
+// JSON path of the family can't be changed.
+func (admin *Admin) AlterColumnFamily(tablePath string, family ColumnFamily) error {
+	return admin.AlterColumnFamilyWithContext(tablePath, family, nil)
+}
+
+// AlterColumnFamilyWithContext method changes TTL and in-memory flag of the column family with the same name,
+// JSON path of the family can't be changed.
+// User defined context is required for this method.
+func (admin *Admin) AlterColumnFamilyWithContext(tablePath string, family ColumnFamily, ctx context.Context) error {
+	request, err := family.toProto()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.AlterColumnFamily(ctx,
+		&AlterColumnFamilyRequest{TablePath: tablePath, ColumnFamily: request})
+	if err != nil {
+		return wrapRpcError(err)
+	}
+	return checkResponseErrorCode(response.GetError())
+}
+
+// DeleteColumnFamily method deletes column family and its data from the table.
+func (admin *Admin) DeleteColumnFamily(tablePath string, name string) error {
+	return admin.DeleteColumnFamilyWithContext(tablePath, name, nil)
+}
+
+// DeleteColumnFamilyWithContext method deletes column family and its data from the table.
+// User defined context is required for this method.
+func (admin *Admin) DeleteColumnFamilyWithContext(tablePath string, name string, ctx context.Context) error {
+	if len(name) == 0 {
+		return errors.New("column family name can't be empty")
+	}
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.DeleteColumnFamily(ctx,
+		&DeleteColumnFamilyRequest{TablePath: tablePath, Name: name})
+	if err != nil {
+		return wrapRpcError(err)
+	}
+	return checkResponseErrorCode(response.GetError())
+}
+
+// ListColumnFamilies method returns column families of the table including the default one.
+func (admin *Admin) ListColumnFamilies(tablePath string) ([]ColumnFamily, error) {
+	return admin.ListColumnFamiliesWithContext(tablePath, nil)
+}
+
+// ListColumnFamiliesWithContext method returns column families of the table including the default one.
+// User defined context is required for this method.
+func (admin *Admin) ListColumnFamiliesWithContext(tablePath string, ctx context.Context) ([]ColumnFamily, error) {
+	descriptor, err := admin.DescribeTableWithContext(tablePath, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return descriptor.ColumnFamilies, nil
+}
+
+// toProto method validates descriptor and converts it into protobuf message
+func (descriptor *TableDescriptor) toProto() (*TableSpec, error) {
+	if descriptor == nil || len(descriptor.Path) == 0 {
+		return nil, errors.New("table path can't be empty")
+	}
+	if descriptor.SplitSize < 0 {
+		return nil, fmt.Errorf("split size of table %v can't be negative", descriptor.Path)
+	}
+	result := &TableSpec{
+		TablePath: descriptor.Path,
+		AutoSplit: descriptor.AutoSplit,
+		SplitSize: descriptor.SplitSize,
+		BulkLoad:  descriptor.BulkLoad,
+	}
+	for _, family := range descriptor.ColumnFamilies {
+		familyProto, err := family.toProto()
+		if err != nil {
+			return nil, err
+		}
+		result.ColumnFamilies = append(result.ColumnFamilies, familyProto)
+	}
+	return result, nil
+}
+
+// tableDescriptorFromProto converts protobuf message into TableDescriptor
+func tableDescriptorFromProto(descriptor *TableSpec) *TableDescriptor {
+	result := &TableDescriptor{
+		Path:      descriptor.GetTablePath(),
+		AutoSplit: descriptor.GetAutoSplit(),
+		SplitSize: descriptor.GetSplitSize(),
+		BulkLoad:  descriptor.GetBulkLoad(),
+	}
+	for _, family := range descriptor.GetColumnFamilies() {
+		result.ColumnFamilies = append(result.ColumnFamilies, ColumnFamily{
+			Name:     family.GetName(),
+			JsonPath: family.GetJsonPath(),
+			TTL:      time.Duration(family.GetTtl()) * time.Second,
+			InMemory: family.GetInMemory(),
+		})
+	}
+	return result
+}
+
+// toProto method validates column family and converts it into protobuf message
+func (family ColumnFamily) toProto() (*ColumnFamilySpec, error) {
+	if len(family.Name) == 0 {
+		return nil, errors.New("column family name can't be empty")
+	}
+	if family.TTL < 0 {
+		return nil, fmt.Errorf("ttl of column family %v can't be negative", family.Name)
+	}
+	return &ColumnFamilySpec{
+		Name:     family.Name,
+		JsonPath: family.JsonPath,
+		Ttl:      int64(family.TTL / time.Second),
+		InMemory: family.InMemory,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// adminClient fake client which keeps table specs in memory, other RPCs aren't implemented
+type adminClient struct {
+	MapRDbServerClient
+	tables map[string]*TableSpec
+}
+
+func (client *adminClient) CreateTableWithDescriptor(
+	ctx context.Context,
+	in *CreateTableWithDescriptorRequest,
+	opts ...grpc.CallOption,
+) (*CreateTableWithDescriptorResponse, error) {
+	if _, ok := client.tables[in.GetTableSpec().GetTablePath()]; ok {
+		return &CreateTableWithDescriptorResponse{Error: &RpcError{ErrCode: ErrorCode_TABLE_ALREADY_EXISTS}}, nil
+	}
+	client.tables[in.GetTableSpec().GetTablePath()] = in.GetTableSpec()
+	return &CreateTableWithDescriptorResponse{}, nil
+}
+
+func (client *adminClient) DescribeTable(
+	ctx context.Context,
+	in *DescribeTableRequest,
+	opts ...grpc.CallOption,
+) (*DescribeTableResponse, error) {
+	spec, ok := client.tables[in.GetTablePath()]
+	if !ok {
+		return &DescribeTableResponse{Error: &RpcError{ErrCode: ErrorCode_TABLE_NOT_FOUND}}, nil
+	}
+	return &DescribeTableResponse{TableSpec: spec}, nil
+}
+
+func (client *adminClient) ListTables(
+	ctx context.Context,
+	in *ListTablesRequest,
+	opts ...grpc.CallOption,
+) (*ListTablesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method ListTables")
+}
+
+func (client *adminClient) ListIndexes(
+	ctx context.Context,
+	in *ListIndexesRequest,
+	opts ...grpc.CallOption,
+) (*ListIndexesResponse, error) {
+	return &ListIndexesResponse{IndexSpecs: []*IndexSpec{{
+		Name:           "idx_age",
+		Fields:         []*IndexFieldSpec{{FieldPath: "age", Descending: true}, {FieldPath: "name"}},
+		IncludedFields: []string{"city"},
+	}}}, nil
+}
+
+func TestAdmin(t *testing.T) {
+	connection := &Connection{stub: &adminClient{tables: map[string]*TableSpec{}}, callTimeout: time.Second}
+	admin := connection.Admin()
+
+	descriptor := MakeTableDescriptor("/apps/users")
+	descriptor.BulkLoad = true
+	descriptor.ColumnFamilies = []ColumnFamily{
+		{Name: "default", TTL: 24 * time.Hour},
+		{Name: "profile", JsonPath: "profile", TTL: 1500 * time.Millisecond, InMemory: true},
+	}
+	store, err := admin.CreateTable(descriptor)
+	assert.Nil(t, err)
+	assert.Equal(t, "/apps/users", store.storeName)
+	_, err = admin.CreateTableWithContext(descriptor, context.Background())
+	assert.True(t, errors.Is(err, ErrTableExists))
+
+	described, err := admin.DescribeTable("/apps/users")
+	assert.Nil(t, err)
+	assert.Equal(t, &TableDescriptor{
+		Path:      "/apps/users",
+		AutoSplit: true,
+		BulkLoad:  true,
+		ColumnFamilies: []ColumnFamily{
+			{Name: "default", TTL: 24 * time.Hour},
+			{Name: "profile", JsonPath: "profile", TTL: time.Second, InMemory: true},
+		},
+	}, described)
+	families, err := admin.ListColumnFamilies("/apps/users")
+	assert.Nil(t, err)
+	assert.Equal(t, described.ColumnFamilies, families)
+	_, err = admin.DescribeTable("/apps/orders")
+	assert.True(t, errors.Is(err, ErrTableNotFound))
+
+	_, err = admin.ListTables("/apps")
+	assert.True(t, errors.Is(err, ErrUnsupportedOperation))
+
+	indexes, err := admin.ListIndexes("/apps/users")
+	assert.Nil(t, err)
+	assert.Equal(t, []IndexDescriptor{{
+		Name:           "idx_age",
+		Fields:         []IndexField{{Path: "age", Order: DESC}, {Path: "name", Order: ASC}},
+		IncludedFields: []string{"city"},
+	}}, indexes)
+	spec, err := indexes[0].toProto()
+	assert.Nil(t, err)
+	assert.Equal(t, []*IndexFieldSpec{{FieldPath: "age", Descending: true}, {FieldPath: "name"}}, spec.Fields)
+}
+
+func TestAdmin_InvalidArguments(t *testing.T) {
+	admin := (&Connection{callTimeout: time.Second}).Admin()
+	tests := []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{"nil descriptor", func() error {
+			_, err := admin.CreateTable(nil)
+			return err
+		}, "table path can't be empty"},
+		{"negative split size", func() error {
+			return admin.AlterTable(&TableDescriptor{Path: "/t", SplitSize: -1})
+		}, "split size of table /t can't be negative"},
+		{"family without name", func() error {
+			_, err := admin.CreateTable(&TableDescriptor{Path: "/t", ColumnFamilies: []ColumnFamily{{JsonPath: "a"}}})
+			return err
+		}, "column family name can't be empty"},
+		{"negative ttl", func() error {
+			return admin.AddColumnFamily("/t", ColumnFamily{Name: "cf", TTL: -time.Second})
+		}, "ttl of column family cf can't be negative"},
+		{"delete family without name", func() error {
+			return admin.DeleteColumnFamily("/t", "")
+		}, "column family name can't be empty"},
+		{"index without fields", func() error {
+			return admin.CreateIndex("/t", &IndexDescriptor{Name: "idx"})
+		}, "index idx must have at least one indexed field"},
+		{"index field without path", func() error {
+			return admin.CreateIndex("/t", &IndexDescriptor{Name: "idx", Fields: []IndexField{{Order: DESC}}})
+		}, "indexed field path of index idx can't be empty"},
+		{"drop index without name", func() error {
+			return admin.DropIndex("/t", "")
+		}, "index name can't be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.call(), tt.want)
+		})
+	}
+}
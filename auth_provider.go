@@ -0,0 +1,301 @@
+package private_maprdb_go_client
+
+import (
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Time before JWT expiration when token is refreshed with basic authentication
+const tokenRefreshMargin = 30 * time.Second
+
+// AuthProvider supplies authorization metadata which is attached to every gRPC request.
+// Authorization is called before request and Done is called after it with the same authorization value,
+// response header and error, so provider can save token issued by server or drop token rejected by server.
+// Done of stream is called as soon as the stream is established, so its header is nil.
+// If server responds with Unauthenticated code and Authorization returns another value request is sent again.
+type AuthProvider interface {
+	// Authorization returns value of authorization metadata, e.g. "bearer <JWT>"
+	Authorization(ctx context.Context) (string, error)
+	// Done handles response of request which was sent with given authorization value
+	Done(authorization string, header metadata.MD, err error)
+}
+
+// AuthOptions authentication parameters of the connection.
+// Provider takes precedence over auth, user and password parameters of connection string.
+type AuthOptions struct {
+	Provider AuthProvider
+}
+
+// CredentialsFunc returns user name and password for basic authentication
+type CredentialsFunc func(ctx context.Context) (user string, password string, err error)
+
+// BasicAuthProvider logs in with user credentials and then uses JWT issued by server.
+// Token is refreshed with basic authentication before it expires or when server rejects it,
+// concurrent requests wait for the single login instead of sending credentials.
+// credentials - function which returns user name and password
+// token - unique JWT from server
+// expiresAt - expiration time from JWT claims, zero if token doesn't expire
+// login - channel which is closed when basic authentication in progress is finished
+// tokenless - server accepted basic authentication without issuing token, e.g. for a stream,
+// so requests aren't serialized until a token is issued
+// All fields except credentials are guarded by mutex.
+type BasicAuthProvider struct {
+	credentials CredentialsFunc
+	mutex       sync.Mutex
+	token       string
+	expiresAt   time.Time
+	login       chan struct{}
+	tokenless   bool
+}
+
+// MakeBasicAuthProvider returns BasicAuthProvider for given user name and password
+func MakeBasicAuthProvider(user, password string) *BasicAuthProvider {
+	return MakeCredentialsAuthProvider(func(ctx context.Context) (string, string, error) {
+		return user, password, nil
+	})
+}
+
+// MakeCredentialsAuthProvider returns BasicAuthProvider which requests credentials from callback before each login
+func MakeCredentialsAuthProvider(credentials CredentialsFunc) *BasicAuthProvider {
+	return &BasicAuthProvider{credentials: credentials}
+}
+
+// Authorization method returns bearer token while it's not close to expiration, otherwise the only caller
+// logs in with basic credentials and other callers wait for the new token.
+func (basicAuthProvider *BasicAuthProvider) Authorization(ctx context.Context) (string, error) {
+	for {
+		basicAuthProvider.mutex.Lock()
+		login := basicAuthProvider.login
+		if basicAuthProvider.isTokenValid(login != nil) {
+			token := basicAuthProvider.token
+			basicAuthProvider.mutex.Unlock()
+			return fmt.Sprintf("bearer %v", token), nil
+		}
+		if login == nil || basicAuthProvider.tokenless {
+			if !basicAuthProvider.tokenless {
+				basicAuthProvider.login = make(chan struct{})
+			}
+			basicAuthProvider.mutex.Unlock()
+			authorization, err := basicAuthProvider.basicAuthorization(ctx)
+			if err != nil {
+				basicAuthProvider.finishLogin(nil, err)
+			}
+			return authorization, err
+		}
+		basicAuthProvider.mutex.Unlock()
+		select {
+		case <-login:
+		case <-ctx.Done():
+			return "", status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// Done method saves token issued by server and drops token rejected by server
+func (basicAuthProvider *BasicAuthProvider) Done(authorization string, header metadata.MD, err error) {
+	if !strings.HasPrefix(authorization, "bearer ") {
+		basicAuthProvider.finishLogin(header, err)
+		return
+	}
+	basicAuthProvider.mutex.Lock()
+	defer basicAuthProvider.mutex.Unlock()
+	if status.Code(err) == codes.Unauthenticated &&
+		basicAuthProvider.token == strings.TrimPrefix(authorization, "bearer ") {
+		basicAuthProvider.token = ""
+		basicAuthProvider.expiresAt = time.Time{}
+	} else if val, ok := header["bearer-token"]; ok && len(val) > 0 {
+		basicAuthProvider.setToken(val[0])
+	}
+}
+
+// basicAuthorization method returns authorization value with encoded credentials
+func (basicAuthProvider *BasicAuthProvider) basicAuthorization(ctx context.Context) (string, error) {
+	user, password, err := basicAuthProvider.credentials(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("basic %v", b64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%v:%v", user, password)))), nil
+}
+
+// isTokenValid method checks whether token can be used for request, mutex must be held by caller.
+// Token which expires soon is still used while other caller refreshes it.
+func (basicAuthProvider *BasicAuthProvider) isTokenValid(refreshing bool) bool {
+	if basicAuthProvider.token == "" {
+		return false
+	}
+	if basicAuthProvider.expiresAt.IsZero() {
+		return true
+	}
+	now := time.Now()
+	if refreshing {
+		return now.Before(basicAuthProvider.expiresAt)
+	}
+	return now.Before(basicAuthProvider.expiresAt.Add(-tokenRefreshMargin))
+}
+
+// setToken method sets JWT token and its expiration time, mutex must be held by caller
+func (basicAuthProvider *BasicAuthProvider) setToken(token string) {
+	basicAuthProvider.token = token
+	basicAuthProvider.expiresAt = tokenExpiration(token)
+	basicAuthProvider.tokenless = false
+}
+
+// finishLogin method saves token from response header of basic authentication request and wakes up waiting callers
+func (basicAuthProvider *BasicAuthProvider) finishLogin(header metadata.MD, err error) {
+	basicAuthProvider.mutex.Lock()
+	defer basicAuthProvider.mutex.Unlock()
+	if val, ok := header["bearer-token"]; ok && len(val) > 0 {
+		basicAuthProvider.setToken(val[0])
+	} else if err == nil {
+		basicAuthProvider.tokenless = true
+	}
+	if basicAuthProvider.login != nil {
+		close(basicAuthProvider.login)
+		basicAuthProvider.login = nil
+	}
+}
+
+// tokenExpiration returns expiration time from "exp" claim of JWT or zero time if it can't be parsed
+func tokenExpiration(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := b64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(claims.Exp), 0)
+}
+
+// TokenAuthProvider sends static JWT with every request
+type TokenAuthProvider struct {
+	token string
+}
+
+// MakeTokenAuthProvider returns TokenAuthProvider for given JWT
+func MakeTokenAuthProvider(token string) *TokenAuthProvider {
+	return &TokenAuthProvider{token: token}
+}
+
+// Authorization method returns bearer token
+func (tokenAuthProvider *TokenAuthProvider) Authorization(ctx context.Context) (string, error) {
+	return fmt.Sprintf("bearer %v", tokenAuthProvider.token), nil
+}
+
+// Done method does nothing because static token can't be refreshed
+func (tokenAuthProvider *TokenAuthProvider) Done(authorization string, header metadata.MD, err error) {
+}
+
+// FileTokenAuthProvider sends JWT read from file and reloads it when file is changed,
+// e.g. when token is rotated by external process.
+type FileTokenAuthProvider struct {
+	path    string
+	mutex   sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// MakeFileTokenAuthProvider returns FileTokenAuthProvider which reads token from given file
+func MakeFileTokenAuthProvider(path string) (*FileTokenAuthProvider, error) {
+	fileTokenAuthProvider := &FileTokenAuthProvider{path: path}
+	if err := fileTokenAuthProvider.reload(); err != nil {
+		return nil, err
+	}
+	return fileTokenAuthProvider, nil
+}
+
+// Authorization method returns bearer token from file, token is read again if file was modified
+func (fileTokenAuthProvider *FileTokenAuthProvider) Authorization(ctx context.Context) (string, error) {
+	fileTokenAuthProvider.mutex.Lock()
+	defer fileTokenAuthProvider.mutex.Unlock()
+	if err := fileTokenAuthProvider.reload(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("bearer %v", fileTokenAuthProvider.token), nil
+}
+
+// Done method forces reading of the file for the next request if server rejected token
+func (fileTokenAuthProvider *FileTokenAuthProvider) Done(authorization string, header metadata.MD, err error) {
+	if status.Code(err) == codes.Unauthenticated {
+		fileTokenAuthProvider.mutex.Lock()
+		defer fileTokenAuthProvider.mutex.Unlock()
+		fileTokenAuthProvider.modTime = time.Time{}
+	}
+}
+
+// reload method reads token if file modification time or size differs from the loaded one, mutex must be held by caller
+func (fileTokenAuthProvider *FileTokenAuthProvider) reload() error {
+	info, err := os.Stat(fileTokenAuthProvider.path)
+	if err != nil {
+		return err
+	}
+	if info.ModTime().Equal(fileTokenAuthProvider.modTime) && info.Size() == fileTokenAuthProvider.size {
+		return nil
+	}
+	content, err := ioutil.ReadFile(fileTokenAuthProvider.path)
+	if err != nil {
+		return err
+	}
+	token := strings.TrimSpace(string(content))
+	if len(token) == 0 {
+		return fmt.Errorf("token file %v is empty", fileTokenAuthProvider.path)
+	}
+	fileTokenAuthProvider.token = token
+	fileTokenAuthProvider.modTime = info.ModTime()
+	fileTokenAuthProvider.size = info.Size()
+	return nil
+}
+
+// makeAuthProvider returns AuthProvider for auth parameter of connection string
+func makeAuthProvider(auth, user, password, token, tokenFile string) (AuthProvider, error) {
+	switch auth {
+	case "basic":
+		return MakeBasicAuthProvider(user, password), nil
+	case "jwt":
+		if len(token) == 0 {
+			return nil, errors.New("'token' parameter is required for 'jwt' authentication")
+		}
+		return MakeTokenAuthProvider(token), nil
+	case "jwtFile":
+		if len(tokenFile) == 0 {
+			return nil, errors.New("'tokenFile' parameter is required for 'jwtFile' authentication")
+		}
+		return MakeFileTokenAuthProvider(tokenFile)
+	default:
+		return nil, fmt.Errorf("unsupported authentication '%v', supported values are 'basic', 'jwt' and 'jwtFile'", auth)
+	}
+}
+
+// observedAuthProvider passes response of every call to observers set by WithAuthObserver
+type observedAuthProvider struct {
+	AuthProvider
+	observers []func(authorization string, header metadata.MD, err error)
+}
+
+// Done method calls observers and then the wrapped provider
+func (observedProvider *observedAuthProvider) Done(authorization string, header metadata.MD, err error) {
+	for _, observer := range observedProvider.observers {
+		observer(authorization, header, err)
+	}
+	observedProvider.AuthProvider.Done(authorization, header, err)
+}
@@ -0,0 +1,145 @@
+package private_maprdb_go_client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTokenExpiration(t *testing.T) {
+	assert.Equal(t, time.Unix(1700000000, 0), tokenExpiration(makeTestToken(`{"sub":"user","exp":1700000000}`)))
+	assert.True(t, tokenExpiration(makeTestToken(`{"sub":"user"}`)).IsZero())
+	assert.True(t, tokenExpiration("opaque-token").IsZero())
+	assert.True(t, tokenExpiration("a.%%%.c").IsZero())
+}
+
+func TestBasicAuthProvider_Authorization(t *testing.T) {
+	provider := MakeBasicAuthProvider("user", "pass")
+	authorization, err := provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "basic dXNlcjpwYXNz", authorization)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = provider.Authorization(ctx)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	token := makeTestToken(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(time.Hour).Unix()))
+	provider.Done(authorization, metadata.Pairs("bearer-token", token), nil)
+	authorization, err = provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "bearer "+token, authorization)
+
+	expiring := makeTestToken(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(tokenRefreshMargin/2).Unix()))
+	provider.Done(authorization, metadata.Pairs("bearer-token", expiring), nil)
+	login, err := provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "basic dXNlcjpwYXNz", login)
+	authorization, err = provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "bearer "+expiring, authorization)
+	provider.Done(login, nil, status.Error(codes.Unavailable, "unavailable"))
+
+	provider.Done(authorization, nil, status.Error(codes.Unauthenticated, "token expired"))
+	authorization, err = provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "basic dXNlcjpwYXNz", authorization)
+	provider.Done(authorization, metadata.MD{}, nil)
+	authorization, err = provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "basic dXNlcjpwYXNz", authorization)
+
+	failing := MakeCredentialsAuthProvider(func(ctx context.Context) (string, string, error) {
+		return "", "", fmt.Errorf("vault is sealed")
+	})
+	_, err = failing.Authorization(context.Background())
+	assert.EqualError(t, err, "vault is sealed")
+	_, err = failing.Authorization(context.Background())
+	assert.EqualError(t, err, "vault is sealed")
+}
+
+func TestFileTokenAuthProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "maprdb-token")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+
+	_, err = MakeFileTokenAuthProvider(path)
+	assert.NotNil(t, err)
+	assert.Nil(t, ioutil.WriteFile(path, []byte(" \n"), 0600))
+	_, err = MakeFileTokenAuthProvider(path)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("first\n"), 0600))
+	provider, err := MakeFileTokenAuthProvider(path)
+	assert.Nil(t, err)
+	authorization, err := provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "bearer first", authorization)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("second"), 0600))
+	modTime := time.Now().Add(time.Minute)
+	assert.Nil(t, os.Chtimes(path, modTime, modTime))
+	authorization, err = provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "bearer second", authorization)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("thirdd"), 0600))
+	assert.Nil(t, os.Chtimes(path, modTime, modTime))
+	provider.Done(authorization, nil, status.Error(codes.Unauthenticated, "token expired"))
+	authorization, err = provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "bearer thirdd", authorization)
+}
+
+func TestParseConnectionString_Auth(t *testing.T) {
+	tests := []struct {
+		name             string
+		connectionString string
+		want             AuthProvider
+		wantErr          bool
+	}{
+		{"default basic", "localhost:5678?user=user;password=pass", &BasicAuthProvider{}, false},
+		{"jwt", "localhost:5678?auth=jwt;token=abc", MakeTokenAuthProvider("abc"), false},
+		{"jwt without token", "localhost:5678?auth=jwt", nil, true},
+		{"jwt file without path", "localhost:5678?auth=jwtFile", nil, true},
+		{"missing jwt file", "localhost:5678?auth=jwtFile;tokenFile=/nonexistent/token", nil, true},
+		{"unsupported", "localhost:5678?auth=kerberos", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := parseConnectionString(tt.connectionString)
+			var provider AuthProvider
+			if err == nil {
+				provider, err = config.authProvider()
+			}
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.IsType(t, tt.want, provider)
+			if token, ok := tt.want.(*TokenAuthProvider); ok {
+				assert.Equal(t, token, provider)
+			}
+		})
+	}
+
+	custom := MakeTokenAuthProvider("custom")
+	config, err := parseConnectionString("localhost:5678?auth=jwt")
+	assert.Nil(t, err)
+	config, err = WithAuthProvider(custom)(config)
+	assert.Nil(t, err)
+	provider, err := config.authProvider()
+	assert.Nil(t, err)
+	assert.Equal(t, custom, provider)
+}
@@ -0,0 +1,247 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Default number of requests which BulkWrite executes in parallel
+const defaultBulkConcurrency = 8
+
+// WriteOperation type of the BulkWrite operation
+type WriteOperation int
+
+// WriteOperation constants
+const (
+	WRITE_INSERT WriteOperation = iota
+	WRITE_INSERT_OR_REPLACE
+	WRITE_REPLACE
+	WRITE_UPDATE
+	WRITE_DELETE
+)
+
+// String representation of WriteOperation constants
+var writeOperations = [...]string{
+	"insert",
+	"insertOrReplace",
+	"replace",
+	"update",
+	"delete",
+}
+
+// Stringer interface implementation
+func (operation WriteOperation) String() string {
+	if operation < 0 || int(operation) >= len(writeOperations) {
+		return fmt.Sprintf("WriteOperation(%d)", int(operation))
+	}
+	return writeOperations[operation]
+}
+
+// InsertMode of WriteOperation constants which are executed with InsertOrReplace request
+var writeInsertModes = map[WriteOperation]InsertMode{
+	WRITE_INSERT:            InsertMode_INSERT,
+	WRITE_INSERT_OR_REPLACE: InsertMode_INSERT_OR_REPLACE,
+	WRITE_REPLACE:           InsertMode_REPLACE,
+}
+
+// WriteOp is a single operation of BulkWrite.
+// Document is required for insert and replace operations,
+// Id is required for update and delete operations and Mutation for update operation.
+// Optional Condition is checked by replace, update and delete operations.
+type WriteOp struct {
+	Operation WriteOperation
+	Document  *Document
+	Id        *BinaryOrStringId
+	Mutation  *MapOrStructMutation
+	Condition *MapOrStructCondition
+}
+
+// InsertOp returns WriteOp which inserts the Document
+func InsertOp(doc *Document) WriteOp {
+	return WriteOp{Operation: WRITE_INSERT, Document: doc}
+}
+
+// InsertOrReplaceOp returns WriteOp which inserts or replaces the Document
+func InsertOrReplaceOp(doc *Document) WriteOp {
+	return WriteOp{Operation: WRITE_INSERT_OR_REPLACE, Document: doc}
+}
+
+// ReplaceOp returns WriteOp which replaces the existing Document
+func ReplaceOp(doc *Document) WriteOp {
+	return WriteOp{Operation: WRITE_REPLACE, Document: doc}
+}
+
+// UpdateOp returns WriteOp which applies the mutation to the document with given id
+func UpdateOp(id *BinaryOrStringId, mutation *MapOrStructMutation) WriteOp {
+	return WriteOp{Operation: WRITE_UPDATE, Id: id, Mutation: mutation}
+}
+
+// DeleteOp returns WriteOp which deletes the document with given id
+func DeleteOp(id *BinaryOrStringId) WriteOp {
+	return WriteOp{Operation: WRITE_DELETE, Id: id}
+}
+
+// BulkWriteOptions parameters of BulkWrite execution
+type BulkWriteOptions struct {
+	// Concurrency maximum number of requests executed in parallel, 8 if not set
+	Concurrency int
+	// RequestsPerSecond maximum rate of requests, unlimited if not set
+	RequestsPerSecond float64
+}
+
+// WriteResult is a result of the single BulkWrite operation.
+// Code is NO_ERROR for successful operation, otherwise Code and Err describe the failure.
+type WriteResult struct {
+	Code ErrorCode
+	Err  error
+}
+
+// BulkWrite method executes insert, replace, update and delete operations in parallel
+// and returns result of each operation in the same order as operations.
+// Failure of the single operation doesn't abort the batch and is reported in its WriteResult.
+// Returned error is not nil only for invalid options.
+// Each request is executed with call timeout of the Connection.
+func (documentStore *DocumentStore) BulkWrite(ops []WriteOp, opts *BulkWriteOptions) ([]WriteResult, error) {
+	return documentStore.BulkWriteWithContext(ops, opts, nil)
+}
+
+// BulkWriteWithContext method executes operations like BulkWrite, but it also stops sending them when ctx is done,
+// results of operations which were not sent contain ctx error in this case and it's returned as well.
+// User defined context is required for this method.
+func (documentStore *DocumentStore) BulkWriteWithContext(
+	ops []WriteOp,
+	opts *BulkWriteOptions,
+	ctx context.Context,
+) ([]WriteResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	concurrency := defaultBulkConcurrency
+	var interval time.Duration
+	if opts != nil {
+		if opts.Concurrency < 0 || opts.RequestsPerSecond < 0 {
+			return nil, errors.New("bulk write concurrency and rate limit can't be negative")
+		}
+		if opts.Concurrency > 0 {
+			concurrency = opts.Concurrency
+		}
+		if opts.RequestsPerSecond > 0 {
+			interval = time.Duration(float64(time.Second) / opts.RequestsPerSecond)
+		}
+	}
+	results := make([]WriteResult, len(ops))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(ops); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				results[index] = documentStore.executeWriteOp(ctx, ops[index])
+			}
+		}()
+	}
+	var ticker *time.Ticker
+	if interval > 0 {
+		ticker = time.NewTicker(interval)
+		defer ticker.Stop()
+	}
+	sent := 0
+	var err error
+dispatch:
+	for sent < len(ops) {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		if ticker != nil && sent > 0 {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				err = ctx.Err()
+				break dispatch
+			}
+		}
+		select {
+		case indexes <- sent:
+			sent++
+		case <-ctx.Done():
+			err = ctx.Err()
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	for index := sent; index < len(ops); index++ {
+		results[index] = makeWriteResult(err)
+	}
+	return results, err
+}
+
+// executeWriteOp executes single operation and converts response into WriteResult
+func (documentStore *DocumentStore) executeWriteOp(ctx context.Context, op WriteOp) WriteResult {
+	ctx, cancel := context.WithTimeout(ctx,
+		documentStore.connection.callTimeout)
+	defer cancel()
+	var rpcError *RpcError
+	var err error
+	switch op.Operation {
+	case WRITE_INSERT, WRITE_INSERT_OR_REPLACE, WRITE_REPLACE:
+		if op.Document == nil || !op.Document.HasId() {
+			return invalidWriteOp(op, "document with _id field is required")
+		}
+		var response *InsertOrReplaceResponse
+		response, err = documentStore.executeInsertOrReplace(
+			writeInsertModes[op.Operation], op.Document, op.Condition, ctx)
+		rpcError = response.GetError()
+	case WRITE_UPDATE:
+		if op.Id == nil || op.Mutation == nil {
+			return invalidWriteOp(op, "id and mutation are required")
+		}
+		var response *UpdateResponse
+		response, err = documentStore.sendUpdate(op.Id, op.Condition, op.Mutation, ctx)
+		rpcError = response.GetError()
+	case WRITE_DELETE:
+		if op.Id == nil {
+			return invalidWriteOp(op, "id is required")
+		}
+		var docString, conditionString string
+		docString, err = getDocumentString(op.Id)
+		if err == nil && op.Condition != nil {
+			conditionString, err = getConditionString(op.Condition)
+		}
+		if err != nil {
+			return invalidWriteOp(op, err.Error())
+		}
+		var response *DeleteResponse
+		response, err = documentStore.sendDelete(docString, conditionString, ctx)
+		rpcError = response.GetError()
+	default:
+		return invalidWriteOp(op, "unsupported operation")
+	}
+	if err == nil {
+		err = newOjaiError(rpcError)
+	}
+	return makeWriteResult(err)
+}
+
+// makeWriteResult returns WriteResult with ErrorCode of OjaiError or UNKNOWN_ERROR for other errors
+func makeWriteResult(err error) WriteResult {
+	if err == nil {
+		return WriteResult{Code: ErrorCode_NO_ERROR}
+	}
+	var ojaiError *OjaiError
+	if errors.As(err, &ojaiError) {
+		return WriteResult{Code: ojaiError.Code, Err: err}
+	}
+	return WriteResult{Code: ErrorCode_UNKNOWN_ERROR, Err: err}
+}
+
+func invalidWriteOp(op WriteOp, message string) WriteResult {
+	return makeWriteResult(&OjaiError{
+		Code:    ErrorCode_INVALID_ARGUMENT,
+		Message: fmt.Sprintf("invalid %v operation: %v", op.Operation, message),
+	})
+}
@@ -0,0 +1,102 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// bulkClient fake client which keeps ids of documents in memory, other RPCs aren't implemented
+type bulkClient struct {
+	MapRDbServerClient
+	mutex    sync.Mutex
+	ids      map[string]bool
+	requests int
+}
+
+func (client *bulkClient) documentId(jsonDocument string) string {
+	var document map[string]interface{}
+	_ = json.Unmarshal([]byte(jsonDocument), &document)
+	id, _ := document["_id"].(string)
+	return id
+}
+
+func (client *bulkClient) InsertOrReplace(
+	ctx context.Context,
+	in *InsertOrReplaceRequest,
+	opts ...grpc.CallOption,
+) (*InsertOrReplaceResponse, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.requests++
+	id := client.documentId(in.GetJsonDocument())
+	if in.GetInsertMode() == InsertMode_INSERT && client.ids[id] {
+		return &InsertOrReplaceResponse{Error: &RpcError{ErrCode: ErrorCode_DOCUMENT_ALREADY_EXISTS}}, nil
+	}
+	client.ids[id] = true
+	return &InsertOrReplaceResponse{Error: &RpcError{}}, nil
+}
+
+func (client *bulkClient) Delete(
+	ctx context.Context,
+	in *DeleteRequest,
+	opts ...grpc.CallOption,
+) (*DeleteResponse, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.requests++
+	delete(client.ids, client.documentId(in.GetJsonDocument()))
+	return &DeleteResponse{Error: &RpcError{}}, nil
+}
+
+func TestDocumentStore_BulkWrite(t *testing.T) {
+	client := &bulkClient{ids: map[string]bool{"existing": true, "gone": true}}
+	store := &DocumentStore{connection: &Connection{stub: client, callTimeout: time.Second}, storeName: "/t"}
+	ops := []WriteOp{
+		InsertOp(MakeDocumentFromMap(map[string]interface{}{"_id": "id1"})),
+		InsertOp(MakeDocumentFromMap(map[string]interface{}{"_id": "existing"})),
+		InsertOp(MakeDocumentFromMap(map[string]interface{}{"name": "no id"})),
+		InsertOrReplaceOp(MakeDocumentFromMap(map[string]interface{}{"_id": "id2"})),
+		DeleteOp(BosiFromString("gone")),
+		UpdateOp(BosiFromString("id1"), nil),
+	}
+	results, err := store.BulkWrite(ops, &BulkWriteOptions{Concurrency: 2})
+	assert.Nil(t, err)
+	codes := make([]ErrorCode, 0, len(results))
+	for _, result := range results {
+		codes = append(codes, result.Code)
+	}
+	assert.Equal(t, []ErrorCode{
+		ErrorCode_NO_ERROR,
+		ErrorCode_DOCUMENT_ALREADY_EXISTS,
+		ErrorCode_INVALID_ARGUMENT,
+		ErrorCode_NO_ERROR,
+		ErrorCode_NO_ERROR,
+		ErrorCode_INVALID_ARGUMENT,
+	}, codes)
+	assert.Nil(t, results[0].Err)
+	assert.EqualError(t, results[5].Err, "INVALID_ARGUMENT: invalid update operation: id and mutation are required")
+	assert.Equal(t, 4, client.requests)
+	assert.Equal(t, map[string]bool{"existing": true, "id1": true, "id2": true}, client.ids)
+
+	results, err = store.BulkWriteWithContext(ops[:1], nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrorCode_DOCUMENT_ALREADY_EXISTS, results[0].Code)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err = store.BulkWriteWithContext(ops[:2], nil, ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []WriteResult{
+		{Code: ErrorCode_UNKNOWN_ERROR, Err: context.Canceled},
+		{Code: ErrorCode_UNKNOWN_ERROR, Err: context.Canceled},
+	}, results)
+
+	_, err = store.BulkWrite(ops, &BulkWriteOptions{Concurrency: -1})
+	assert.EqualError(t, err, "bulk write concurrency and rate limit can't be negative")
+}
@@ -0,0 +1,242 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ChangeType kind of document change
+type ChangeType int
+
+const (
+	ChangeInsert ChangeType = iota
+	ChangeUpdate
+	ChangeDelete
+)
+
+var changeTypes = [...]string{
+	"insert",
+	"update",
+	"delete",
+}
+
+// String method returns name of the change type
+func (changeType ChangeType) String() string {
+	if changeType < 0 || int(changeType) >= len(changeTypes) {
+		return "unknown"
+	}
+	return changeTypes[changeType]
+}
+
+// ResumeToken opaque position of change in the changelog, it can be stored
+// and passed to ChangesOptions to continue streaming after the change
+type ResumeToken string
+
+// ChangesOptions parameters of change stream
+// Changelog path and topic of changelog stream, e.g. "/apps/changelog:users", the first changelog of the table by default
+// ResumeAfter token of the last processed change, changes made after the call are streamed if it's empty
+// FieldPaths only changes of these fields are streamed, all changes if it's empty
+// IncludeDocuments whether documents before and after the change are included into events
+type ChangesOptions struct {
+	Changelog        string
+	ResumeAfter      ResumeToken
+	FieldPaths       []string
+	IncludeDocuments bool
+}
+
+// ChangeEvent change of document read from the changelog
+// Type kind of the change
+// Id document with _id field of the changed document
+// Before document before the change, nil for inserts or if documents aren't included or available
+// After document after the change, nil for deletes or if documents aren't included or available
+// ChangedFields field paths changed by the update
+// Timestamp time of the change
+// ResumeToken position of the change in the changelog
+type ChangeEvent struct {
+	Type          ChangeType
+	Id            *Document
+	Before        *Document
+	After         *Document
+	ChangedFields []string
+	Timestamp     time.Time
+	ResumeToken   ResumeToken
+}
+
+// ChangeStream is a pull-based iterator over Changes gRPC response stream.
+// The stream doesn't end by itself, it's read until ctx of ChangesWithContext is done or it's closed.
+type ChangeStream struct {
+	responseStream MapRDbServer_ChangesClient
+	cancel         context.CancelFunc
+	current        *ChangeEvent
+	resumeToken    ResumeToken
+	err            error
+	closed         bool
+}
+
+// Result of a single Recv call on the change stream
+type changesRecvResult struct {
+	element *ChangesResponse
+	err     error
+}
+
+// Changes method executes gRPC Changes request and returns stream of changes of the store,
+// the stream is read until it's closed.
+func (documentStore *DocumentStore) Changes(opts *ChangesOptions) (*ChangeStream, error) {
+	return documentStore.ChangesWithContext(opts, nil)
+}
+
+// ChangesWithContext method executes gRPC Changes request and returns stream of changes of the store,
+// the stream is read until ctx is done or the stream is closed.
+// User defined context is required for this method.
+func (documentStore *DocumentStore) ChangesWithContext(opts *ChangesOptions, ctx context.Context) (*ChangeStream, error) {
+	if opts == nil {
+		opts = &ChangesOptions{}
+	}
+	for _, fieldPath := range opts.FieldPaths {
+		if len(fieldPath) == 0 {
+			return nil, errors.New("field path of changes filter can't be empty")
+		}
+	}
+	// The context must stay alive while the changes are streamed, so it is released by ChangeStream.Close.
+	// Call timeout isn't used for nil context, since the stream doesn't end by itself.
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	responseStream, err := documentStore.connection.stub.Changes(ctx, &ChangesRequest{
+		TablePath:        documentStore.storeName,
+		PayloadEncoding:  PayloadEncoding_JSON_ENCODING,
+		Changelog:        opts.Changelog,
+		ResumeToken:      string(opts.ResumeAfter),
+		FieldPaths:       opts.FieldPaths,
+		IncludeDocuments: opts.IncludeDocuments,
+	})
+	if err != nil {
+		cancel()
+		return nil, wrapRpcError(err)
+	}
+	return &ChangeStream{responseStream: responseStream, cancel: cancel, resumeToken: opts.ResumeAfter}, nil
+}
+
+// Next waits for the next change and returns true if it is available through the Event method.
+// Next returns false when the stream is closed or an error occurred, Err must be checked after Next returns false.
+func (stream *ChangeStream) Next() bool {
+	return stream.NextWithContext(nil)
+}
+
+// NextWithContext waits for the next change like Next, but it also returns false when ctx is done.
+// User defined context is required for this method.
+func (stream *ChangeStream) NextWithContext(ctx context.Context) bool {
+	stream.current = nil
+	if stream.closed || stream.err != nil {
+		return false
+	}
+	event, err := stream.receive(ctx)
+	if err != nil {
+		if err != io.EOF {
+			stream.err = err
+		}
+		stream.Close()
+		return false
+	}
+	stream.current = event
+	stream.resumeToken = event.ResumeToken
+	return true
+}
+
+// receive waits for the next ChangesResponse, checks its error code and decodes it into the ChangeEvent.
+func (stream *ChangeStream) receive(ctx context.Context) (*ChangeEvent, error) {
+	var element *ChangesResponse
+	var err error
+	if ctx == nil || ctx.Done() == nil {
+		element, err = stream.responseStream.Recv()
+	} else {
+		resultChannel := make(chan changesRecvResult, 1)
+		go func() {
+			element, err := stream.responseStream.Recv()
+			resultChannel <- changesRecvResult{element: element, err: err}
+		}()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case result := <-resultChannel:
+			element, err = result.element, result.err
+		}
+	}
+	if err == io.EOF {
+		return nil, err
+	}
+	if err != nil {
+		return nil, wrapRpcError(err)
+	}
+	if element.GetError().GetErrCode() != ErrorCode_NO_ERROR {
+		return nil, newOjaiError(element.GetError())
+	}
+	return makeChangeEvent(element)
+}
+
+// makeChangeEvent decodes ChangesResponse into the ChangeEvent
+func makeChangeEvent(element *ChangesResponse) (*ChangeEvent, error) {
+	event := &ChangeEvent{
+		ChangedFields: element.GetChangedFieldPaths(),
+		Timestamp:     time.UnixMilli(element.GetTimestamp()),
+		ResumeToken:   ResumeToken(element.GetResumeToken()),
+	}
+	switch element.GetType() {
+	case ChangeRecordType_CHANGE_INSERT:
+		event.Type = ChangeInsert
+	case ChangeRecordType_CHANGE_UPDATE:
+		event.Type = ChangeUpdate
+	case ChangeRecordType_CHANGE_DELETE:
+		event.Type = ChangeDelete
+	default:
+		return nil, fmt.Errorf("unknown type of change %v", element.GetType())
+	}
+	var err error
+	if event.Id, err = decodeChangeDocument(element.GetJsonId()); err != nil {
+		return nil, err
+	}
+	if event.Before, err = decodeChangeDocument(element.GetJsonBefore()); err != nil {
+		return nil, err
+	}
+	if event.After, err = decodeChangeDocument(element.GetJsonAfter()); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// decodeChangeDocument decodes JSON encoded document of change or returns nil if it's empty
+func decodeChangeDocument(jsonDocument string) (*Document, error) {
+	if len(jsonDocument) == 0 {
+		return nil, nil
+	}
+	return MakeDocumentFromJson(jsonDocument)
+}
+
+// Event returns the change read by the last successful call of Next or nil.
+func (stream *ChangeStream) Event() *ChangeEvent {
+	return stream.current
+}
+
+// ResumeToken returns token of the last change read by Next or ResumeAfter of options
+// if changes weren't read yet, it can be used to continue streaming after restart.
+func (stream *ChangeStream) ResumeToken() ResumeToken {
+	return stream.resumeToken
+}
+
+// Err returns the first error occurred during the iteration or nil.
+func (stream *ChangeStream) Err() error {
+	return stream.err
+}
+
+// Close stops the iteration and cancels the Changes request. Close can be called more than once.
+func (stream *ChangeStream) Close() {
+	if stream.closed {
+		return
+	}
+	stream.closed = true
+	stream.cancel()
+}
@@ -0,0 +1,121 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// changesClientMock implements MapRDbServer_ChangesClient over predefined responses
+type changesClientMock struct {
+	grpc.ClientStream
+	responses []*ChangesResponse
+	received  int
+}
+
+func (client *changesClientMock) Recv() (*ChangesResponse, error) {
+	if client.received == len(client.responses) {
+		return nil, io.EOF
+	}
+	response := client.responses[client.received]
+	client.received++
+	return response, nil
+}
+
+// changesClient fake client which returns predefined Changes stream, other RPCs aren't implemented
+type changesClient struct {
+	MapRDbServerClient
+	stream  *changesClientMock
+	request *ChangesRequest
+	ctx     context.Context
+}
+
+func (client *changesClient) Changes(
+	ctx context.Context,
+	in *ChangesRequest,
+	opts ...grpc.CallOption,
+) (MapRDbServer_ChangesClient, error) {
+	client.ctx = ctx
+	client.request = in
+	return client.stream, nil
+}
+
+func TestDocumentStore_Changes(t *testing.T) {
+	client := &changesClient{stream: &changesClientMock{responses: []*ChangesResponse{
+		{Type: ChangeRecordType_CHANGE_DELETE, ResumeToken: "t1", Id: &ChangesResponse_JsonId{JsonId: `{"_id":"id1"}`}},
+	}}}
+	store := &DocumentStore{connection: &Connection{stub: client, callTimeout: time.Second}, storeName: "/t"}
+	stream, err := store.ChangesWithContext(&ChangesOptions{ResumeAfter: "t0"}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "/t", client.request.GetTablePath())
+	assert.Equal(t, "t0", client.request.GetResumeToken())
+	_, hasDeadline := client.ctx.Deadline()
+	assert.False(t, hasDeadline)
+	assert.True(t, stream.Next())
+	assert.Equal(t, ChangeDelete, stream.Event().Type)
+	stream.Close()
+	assert.Equal(t, context.Canceled, client.ctx.Err())
+}
+
+func TestChangeStream(t *testing.T) {
+	canceled := false
+	stream := &ChangeStream{
+		responseStream: &changesClientMock{responses: []*ChangesResponse{
+			{
+				Type:              ChangeRecordType_CHANGE_UPDATE,
+				ResumeToken:       "t1",
+				Timestamp:         1700000000000,
+				ChangedFieldPaths: []string{"address.city"},
+				Id:                &ChangesResponse_JsonId{JsonId: `{"_id":"id1"}`},
+				Before:            &ChangesResponse_JsonBefore{JsonBefore: `{"_id":"id1","address":{"city":"Boston"}}`},
+				After:             &ChangesResponse_JsonAfter{JsonAfter: `{"_id":"id1","address":{"city":"Austin"}}`},
+			},
+			{Type: ChangeRecordType_CHANGE_DELETE, ResumeToken: "t2", Id: &ChangesResponse_JsonId{JsonId: `{"_id":"id2"}`}},
+			{Error: &RpcError{ErrCode: ErrorCode_TABLE_NOT_FOUND, ErrorMessage: "table /t not found"}},
+		}},
+		cancel:      func() { canceled = true },
+		resumeToken: "t0",
+	}
+	assert.Equal(t, ResumeToken("t0"), stream.ResumeToken())
+
+	assert.True(t, stream.Next())
+	event := stream.Event()
+	assert.Equal(t, ChangeUpdate, event.Type)
+	assert.Equal(t, "update", event.Type.String())
+	id, err := event.Id.GetIdString()
+	assert.Nil(t, err)
+	assert.Equal(t, "id1", id)
+	city, err := event.After.GetString("address.city")
+	assert.Nil(t, err)
+	assert.Equal(t, "Austin", city)
+	assert.Equal(t, []string{"address.city"}, event.ChangedFields)
+	assert.True(t, time.UnixMilli(1700000000000).Equal(event.Timestamp))
+	assert.Equal(t, ResumeToken("t1"), stream.ResumeToken())
+
+	assert.True(t, stream.Next())
+	assert.Equal(t, ChangeDelete, stream.Event().Type)
+	assert.Nil(t, stream.Event().Before)
+	assert.Nil(t, stream.Event().After)
+
+	assert.False(t, stream.Next())
+	assert.EqualError(t, stream.Err(), "TABLE_NOT_FOUND: table /t not found")
+	assert.Nil(t, stream.Event())
+	assert.Equal(t, ResumeToken("t2"), stream.ResumeToken())
+	assert.True(t, canceled)
+	assert.False(t, stream.Next())
+}
+
+func TestMakeChangeEvent_Invalid(t *testing.T) {
+	_, err := makeChangeEvent(&ChangesResponse{})
+	assert.EqualError(t, err, "unknown type of change UNKNOWN_CHANGE_TYPE")
+	_, err = makeChangeEvent(&ChangesResponse{Type: ChangeRecordType_CHANGE_INSERT, After: &ChangesResponse_JsonAfter{JsonAfter: "{"}})
+	assert.NotNil(t, err)
+	assert.Equal(t, "unknown", ChangeType(3).String())
+
+	_, err = (&DocumentStore{}).Changes(&ChangesOptions{FieldPaths: []string{""}})
+	assert.EqualError(t, err, "field path of changes filter can't be empty")
+}
@@ -0,0 +1,753 @@
+package private_maprdb_go_client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// OJAI value types in the order which is used for comparison of values of different types
+const (
+	typeRankNull = iota
+	typeRankBoolean
+	typeRankString
+	typeRankNumber
+	typeRankDate
+	typeRankTime
+	typeRankTimestamp
+	typeRankInterval
+	typeRankBinary
+	typeRankMap
+	typeRankArray
+	typeRankUnknown
+)
+
+// OJAI type names and codes which are accepted by $typeof and $nottypeof operations
+var ojaiTypeCodes = map[string]int{
+	"null":      1,
+	"boolean":   2,
+	"string":    3,
+	"byte":      4,
+	"short":     5,
+	"int":       6,
+	"long":      7,
+	"float":     8,
+	"double":    9,
+	"decimal":   10,
+	"date":      11,
+	"time":      12,
+	"timestamp": 13,
+	"interval":  14,
+	"binary":    15,
+	"map":       16,
+	"array":     17,
+}
+
+// Evaluate method evaluates built Condition against the Document on the client side
+// and returns true if the Document matches the Condition.
+func (condition *Condition) Evaluate(doc *Document) (bool, error) {
+	if !condition.IsBuilt() {
+		return false, errors.New("build condition before evaluate it")
+	}
+	return EvaluateConditionMap(condition.AsMap(), doc)
+}
+
+// EvaluateConditionMap evaluates condition map in the format of Condition.AsMap() against the Document
+// and returns true if the Document matches the condition. Empty condition matches any Document.
+func EvaluateConditionMap(condition map[string]interface{}, doc *Document) (bool, error) {
+	var root interface{} = map[string]interface{}{}
+	if doc != nil && doc.documentMap != nil {
+		root = doc.documentMap
+	}
+	return evaluateCondition(condition, root)
+}
+
+// evaluateCondition returns true if all operations of the condition hold for the root value
+func evaluateCondition(condition map[string]interface{}, root interface{}) (bool, error) {
+	for operation, operand := range condition {
+		result, err := evaluateOperation(operation, operand, root)
+		if err != nil || !result {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func evaluateOperation(operation string, operand interface{}, root interface{}) (bool, error) {
+	switch operation {
+	case logicalOperations[AND], logicalOperations[OR]:
+		conditions, err := conditionList(operation, operand)
+		if err != nil {
+			return false, err
+		}
+		return evaluateLogical(operation == logicalOperations[AND], conditions, root)
+	case logicalOperations[ELEMENT_AND]:
+		return evaluateElementAnd(operand, root)
+	case conditionQueryOperations[EXISTS], conditionQueryOperations[NOT_EXISTS]:
+		return evaluateExists(operation == conditionQueryOperations[EXISTS], operand, root)
+	}
+	fields, ok := operand.(map[string]interface{})
+	if !ok {
+		return false, invalidOperand(operation, operand)
+	}
+	for fieldPath, expected := range fields {
+		path, err := parseConditionPath(fieldPath)
+		if err != nil {
+			return false, err
+		}
+		result, err := evaluateField(operation, resolveConditionPath(root, path), expected)
+		if err != nil || !result {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func evaluateLogical(isAnd bool, conditions []map[string]interface{}, root interface{}) (bool, error) {
+	for _, condition := range conditions {
+		result, err := evaluateCondition(condition, root)
+		if err != nil {
+			return false, err
+		}
+		if result != isAnd {
+			return result, nil
+		}
+	}
+	return isAnd, nil
+}
+
+// evaluateElementAnd returns true if at least one element of the array matches all the nested conditions.
+// Field paths of nested conditions are relative to the array element.
+func evaluateElementAnd(operand interface{}, root interface{}) (bool, error) {
+	fields, ok := operand.(map[string]interface{})
+	if !ok {
+		return false, invalidOperand(logicalOperations[ELEMENT_AND], operand)
+	}
+	for fieldPath, value := range fields {
+		arrayPath := strings.TrimSuffix(fieldPath, "[]")
+		path, err := parseConditionPath(arrayPath)
+		if err != nil {
+			return false, err
+		}
+		conditions, err := conditionList(logicalOperations[ELEMENT_AND], value)
+		if err != nil {
+			return false, err
+		}
+		conditions = relativeConditions(conditions, arrayPath)
+		found := false
+		for _, array := range resolveConditionPath(root, path) {
+			elements, ok := array.([]interface{})
+			if !ok {
+				continue
+			}
+			for _, element := range elements {
+				found, err = evaluateLogical(true, conditions, element)
+				if err != nil {
+					return false, err
+				}
+				if found {
+					break
+				}
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// relativeConditions strips array path prefix from the field paths of $elementAnd conditions
+func relativeConditions(conditions []map[string]interface{}, arrayPath string) []map[string]interface{} {
+	prefix := arrayPath + "[]."
+	var result []map[string]interface{}
+	for _, condition := range conditions {
+		converted := make(map[string]interface{}, len(condition))
+		for operation, operand := range condition {
+			switch o := operand.(type) {
+			case string:
+				converted[operation] = strings.TrimPrefix(o, prefix)
+			case map[string]interface{}:
+				fields := make(map[string]interface{}, len(o))
+				for fieldPath, value := range o {
+					fields[strings.TrimPrefix(fieldPath, prefix)] = value
+				}
+				converted[operation] = fields
+			case []interface{}:
+				nested, err := conditionList(operation, o)
+				if err != nil {
+					converted[operation] = operand
+					continue
+				}
+				var list []interface{}
+				for _, condition := range relativeConditions(nested, arrayPath) {
+					list = append(list, condition)
+				}
+				converted[operation] = list
+			default:
+				converted[operation] = operand
+			}
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
+func evaluateExists(exists bool, operand interface{}, root interface{}) (bool, error) {
+	var fieldPaths []interface{}
+	if list, ok := operand.([]interface{}); ok {
+		fieldPaths = list
+	} else {
+		fieldPaths = []interface{}{operand}
+	}
+	for _, fieldPath := range fieldPaths {
+		s, ok := fieldPath.(string)
+		if !ok {
+			return false, invalidOperand(conditionQueryOperations[EXISTS], operand)
+		}
+		path, err := parseConditionPath(s)
+		if err != nil {
+			return false, err
+		}
+		if (len(resolveConditionPath(root, path)) != 0) != exists {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// evaluateField checks values found by the field path against expected value.
+// Positive operations hold if any of the values matches,
+// negative operations hold if the corresponding positive operation doesn't hold.
+func evaluateField(operation string, values []interface{}, expected interface{}) (bool, error) {
+	var positive string
+	switch operation {
+	case comparisonQueryOperations[NOT_EQUAL]:
+		positive = comparisonQueryOperations[EQUAL]
+	case conditionQueryOperations[NOT_IN]:
+		positive = conditionQueryOperations[IN]
+	case conditionQueryOperations[NOT_TYPE_OF]:
+		positive = conditionQueryOperations[TYPE_OF]
+	case conditionQueryOperations[NOT_MATCHES]:
+		positive = conditionQueryOperations[MATCHES]
+	case conditionQueryOperations[NOT_LIKE]:
+		positive = conditionQueryOperations[LIKE]
+	}
+	if len(positive) != 0 {
+		result, err := evaluateField(positive, values, expected)
+		return !result && err == nil, err
+	}
+	match, err := fieldMatcher(operation, expected)
+	if err != nil {
+		return false, err
+	}
+	for _, value := range values {
+		if match(value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fieldMatcher returns function which checks single value for positive operation
+func fieldMatcher(operation string, expected interface{}) (func(value interface{}) bool, error) {
+	expected = conditionValue(expected)
+	switch operation {
+	case comparisonQueryOperations[EQUAL]:
+		return func(value interface{}) bool { return valuesEqual(value, expected) }, nil
+	case comparisonQueryOperations[LESS], comparisonQueryOperations[LESS_OR_EQUAL],
+		comparisonQueryOperations[GREATER], comparisonQueryOperations[GREATER_OR_EQUAL]:
+		return func(value interface{}) bool {
+			if typeRank(value) != typeRank(expected) {
+				return false
+			}
+			result := CompareValues(value, expected)
+			switch operation {
+			case comparisonQueryOperations[LESS]:
+				return result < 0
+			case comparisonQueryOperations[LESS_OR_EQUAL]:
+				return result <= 0
+			case comparisonQueryOperations[GREATER]:
+				return result > 0
+			default:
+				return result >= 0
+			}
+		}, nil
+	case conditionQueryOperations[IN]:
+		list, ok := expected.([]interface{})
+		if !ok {
+			return nil, invalidOperand(operation, expected)
+		}
+		return func(value interface{}) bool {
+			for _, element := range list {
+				if valuesEqual(value, conditionValue(element)) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case conditionQueryOperations[TYPE_OF]:
+		code, err := typeCode(expected)
+		if err != nil {
+			return nil, err
+		}
+		return func(value interface{}) bool { return ojaiTypeCodes[ojaiTypeName(value)] == code }, nil
+	case conditionQueryOperations[MATCHES]:
+		regex, err := matchesRegexp(expected)
+		if err != nil {
+			return nil, err
+		}
+		return func(value interface{}) bool {
+			s, ok := value.(string)
+			return ok && regex.MatchString(s)
+		}, nil
+	case conditionQueryOperations[LIKE]:
+		regex, err := likeRegexp(expected)
+		if err != nil {
+			return nil, err
+		}
+		return func(value interface{}) bool {
+			s, ok := value.(string)
+			return ok && regex.MatchString(s)
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported condition operation %v", operation)
+}
+
+func conditionList(operation string, operand interface{}) ([]map[string]interface{}, error) {
+	switch o := operand.(type) {
+	case []map[string]interface{}:
+		return o, nil
+	case []interface{}:
+		var conditions []map[string]interface{}
+		for _, element := range o {
+			switch e := element.(type) {
+			case map[string]interface{}:
+				conditions = append(conditions, e)
+			case *Condition:
+				conditions = append(conditions, e.AsMap())
+			default:
+				return nil, invalidOperand(operation, operand)
+			}
+		}
+		return conditions, nil
+	case map[string]interface{}:
+		return []map[string]interface{}{o}, nil
+	}
+	return nil, invalidOperand(operation, operand)
+}
+
+// conditionValue converts user values of condition into the types which are stored in the Document
+func conditionValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *Document:
+		return v.AsMap()
+	case ODate:
+		return &v
+	case OTime:
+		return &v
+	case OTimestamp:
+		return &v
+	case OInterval:
+		return &v
+	case Decimal:
+		return &v
+	}
+	return value
+}
+
+func typeCode(expected interface{}) (int, error) {
+	switch e := expected.(type) {
+	case string:
+		if code, ok := ojaiTypeCodes[strings.ToLower(e)]; ok {
+			return code, nil
+		}
+	default:
+		if n, ok := toNumber(expected); ok && n.isInt {
+			return int(n.i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown OJAI type %v", expected)
+}
+
+func matchesRegexp(expected interface{}) (*regexp.Regexp, error) {
+	switch e := expected.(type) {
+	case *regexp.Regexp:
+		return regexp.Compile("^(?:" + e.String() + ")$")
+	case string:
+		return regexp.Compile("^(?:" + e + ")$")
+	}
+	return nil, invalidOperand(conditionQueryOperations[MATCHES], expected)
+}
+
+// likeRegexp converts SQL LIKE expression with optional escape character into regular expression
+func likeRegexp(expected interface{}) (*regexp.Regexp, error) {
+	var expression string
+	var escape rune
+	switch e := expected.(type) {
+	case string:
+		expression = e
+	case []interface{}:
+		if len(e) != 2 {
+			return nil, invalidOperand(conditionQueryOperations[LIKE], expected)
+		}
+		s, ok := e[0].(string)
+		if !ok {
+			return nil, invalidOperand(conditionQueryOperations[LIKE], expected)
+		}
+		expression = s
+		if e[1] != nil {
+			escapeString, ok := e[1].(string)
+			if !ok || len([]rune(escapeString)) != 1 {
+				return nil, invalidOperand(conditionQueryOperations[LIKE], expected)
+			}
+			escape = []rune(escapeString)[0]
+		}
+	default:
+		return nil, invalidOperand(conditionQueryOperations[LIKE], expected)
+	}
+	var pattern strings.Builder
+	pattern.WriteString("^(?s:")
+	escaped := false
+	for _, c := range expression {
+		switch {
+		case escaped:
+			pattern.WriteString(regexp.QuoteMeta(string(c)))
+			escaped = false
+		case escape != 0 && c == escape:
+			escaped = true
+		case c == '%':
+			pattern.WriteString(".*")
+		case c == '_':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	pattern.WriteString(")$")
+	return regexp.Compile(pattern.String())
+}
+
+func invalidOperand(operation string, operand interface{}) error {
+	return fmt.Errorf("invalid %v operand %v (%T)", operation, operand, operand)
+}
+
+// parseConditionPath parses field path of the condition, [] segment is allowed in conditions
+func parseConditionPath(fieldPath string) ([]FieldSegment, error) {
+	path, err := MakeFieldPath(fieldPath)
+	if err != nil {
+		return nil, err
+	}
+	return path.segments, nil
+}
+
+// resolveConditionPath returns all values which are found by the field path,
+// [] segment selects every element of the array
+func resolveConditionPath(root interface{}, path []FieldSegment) []interface{} {
+	if len(path) == 0 {
+		return []interface{}{root}
+	}
+	s := path[0]
+	switch {
+	case s.IsAnyIndex():
+		array, ok := root.([]interface{})
+		if !ok {
+			return nil
+		}
+		var values []interface{}
+		for _, element := range array {
+			values = append(values, resolveConditionPath(element, path[1:])...)
+		}
+		return values
+	case s.isIndex:
+		array, ok := root.([]interface{})
+		if !ok || s.index >= len(array) {
+			return nil
+		}
+		return resolveConditionPath(array[s.index], path[1:])
+	default:
+		m, ok := root.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value, ok := m[s.name]
+		if !ok {
+			return nil
+		}
+		return resolveConditionPath(value, path[1:])
+	}
+}
+
+// CompareValues compares two OJAI values and returns -1, 0 or 1.
+// Numbers of all types are compared by value, dates, times, timestamps, binaries, maps and arrays
+// are compared with values of the same type, values of different types are ordered by OJAI type order:
+// null, boolean, string, number, date, time, timestamp, interval, binary, map, array.
+func CompareValues(a, b interface{}) int {
+	a = conditionValue(a)
+	b = conditionValue(b)
+	aRank, bRank := typeRank(a), typeRank(b)
+	if aRank != bRank {
+		return compareInt(aRank, bRank)
+	}
+	switch aRank {
+	case typeRankBoolean:
+		av, bv := a.(bool), b.(bool)
+		if av == bv {
+			return 0
+		}
+		if !av {
+			return -1
+		}
+		return 1
+	case typeRankString:
+		return strings.Compare(a.(string), b.(string))
+	case typeRankNumber:
+		_, aIsDecimal := a.(*Decimal)
+		_, bIsDecimal := b.(*Decimal)
+		if aIsDecimal || bIsDecimal {
+			return exactNumber(a).Cmp(exactNumber(b))
+		}
+		an, _ := toNumber(a)
+		bn, _ := toNumber(b)
+		return compareNumbers(an, bn)
+	case typeRankDate:
+		ad, bd := a.(*ODate).d, b.(*ODate).d
+		if result := compareInt(ad.Year(), bd.Year()); result != 0 {
+			return result
+		}
+		if result := compareInt(int(ad.Month()), int(bd.Month())); result != 0 {
+			return result
+		}
+		return compareInt(ad.Day(), bd.Day())
+	case typeRankTime:
+		at, bt := a.(*OTime), b.(*OTime)
+		return compareInt(timeOfDay(at), timeOfDay(bt))
+	case typeRankTimestamp:
+		at, bt := a.(*OTimestamp).DateTime(), b.(*OTimestamp).DateTime()
+		switch {
+		case at.Before(bt):
+			return -1
+		case at.After(bt):
+			return 1
+		}
+		return 0
+	case typeRankInterval:
+		return compareInt64(a.(*OInterval).Milliseconds(), b.(*OInterval).Milliseconds())
+	case typeRankBinary:
+		return bytes.Compare(a.([]byte), b.([]byte))
+	case typeRankMap:
+		return compareMaps(a.(map[string]interface{}), b.(map[string]interface{}))
+	case typeRankArray:
+		av, bv := a.([]interface{}), b.([]interface{})
+		for i := 0; i < len(av) && i < len(bv); i++ {
+			if result := CompareValues(av[i], bv[i]); result != 0 {
+				return result
+			}
+		}
+		return compareInt(len(av), len(bv))
+	case typeRankUnknown:
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	}
+	return 0
+}
+
+// valuesEqual returns true if values have the same OJAI type family and equal content
+func valuesEqual(a, b interface{}) bool {
+	a = conditionValue(a)
+	b = conditionValue(b)
+	if typeRank(a) != typeRank(b) {
+		return false
+	}
+	if typeRank(a) == typeRankUnknown {
+		return reflect.DeepEqual(a, b)
+	}
+	return CompareValues(a, b) == 0
+}
+
+func compareMaps(a, b map[string]interface{}) int {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		av, aOk := a[key]
+		bv, bOk := b[key]
+		switch {
+		case !aOk:
+			return 1
+		case !bOk:
+			return -1
+		}
+		if result := CompareValues(av, bv); result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
+func timeOfDay(t *OTime) int {
+	return ((t.GetHour()*60+t.GetMinute())*60+t.GetSecond())*1000000000 + t.GetNanosecond()
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareInt(a, b int) int {
+	return compareInt64(int64(a), int64(b))
+}
+
+// typeRank returns position of the value type in OJAI type order
+func typeRank(value interface{}) int {
+	switch value.(type) {
+	case nil:
+		return typeRankNull
+	case bool:
+		return typeRankBoolean
+	case string:
+		return typeRankString
+	case *ODate:
+		return typeRankDate
+	case *OTime:
+		return typeRankTime
+	case *OTimestamp:
+		return typeRankTimestamp
+	case *OInterval:
+		return typeRankInterval
+	case *Decimal:
+		return typeRankNumber
+	case []byte:
+		return typeRankBinary
+	case map[string]interface{}:
+		return typeRankMap
+	case []interface{}:
+		return typeRankArray
+	}
+	if _, ok := toNumber(value); ok {
+		return typeRankNumber
+	}
+	return typeRankUnknown
+}
+
+// ojaiTypeName returns OJAI type name of the value stored in the Document
+func ojaiTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case int8:
+		return "byte"
+	case int16:
+		return "short"
+	case int32:
+		return "int"
+	case int, int64:
+		return "long"
+	case float32:
+		return "float"
+	case float64:
+		return "double"
+	case *Decimal:
+		return "decimal"
+	case *OInterval, OInterval:
+		return "interval"
+	case *ODate, ODate:
+		return "date"
+	case *OTime, OTime:
+		return "time"
+	case *OTimestamp, OTimestamp:
+		return "timestamp"
+	case []byte:
+		return "binary"
+	case map[string]interface{}, *Document:
+		return "map"
+	case []interface{}:
+		return "array"
+	}
+	return ""
+}
+
+// number keeps integer values without loss of precision
+type number struct {
+	i     int64
+	f     float64
+	isInt bool
+}
+
+func toNumber(value interface{}) (number, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{i: v.Int(), f: float64(v.Int()), isInt: true}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > 1<<63-1 {
+			return number{f: float64(v.Uint())}, true
+		}
+		return number{i: int64(v.Uint()), f: float64(v.Uint()), isInt: true}, true
+	case reflect.Float32, reflect.Float64:
+		return number{f: v.Float()}, true
+	}
+	return number{}, false
+}
+
+// exactNumber converts number of any type into big.Rat, so decimals are compared without loss of precision
+func exactNumber(value interface{}) *big.Rat {
+	if decimal, ok := value.(*Decimal); ok {
+		return decimal.Rat()
+	}
+	n, _ := toNumber(value)
+	if n.isInt {
+		return new(big.Rat).SetInt64(n.i)
+	}
+	if exact := new(big.Rat).SetFloat64(n.f); exact != nil {
+		return exact
+	}
+	// Infinities are compared as the largest float64 values and NaN as zero
+	if math.IsInf(n.f, 0) {
+		return new(big.Rat).SetFloat64(math.Copysign(math.MaxFloat64, n.f))
+	}
+	return new(big.Rat)
+}
+
+func compareNumbers(a, b number) int {
+	if a.isInt && b.isInt {
+		switch {
+		case a.i < b.i:
+			return -1
+		case a.i > b.i:
+			return 1
+		}
+		return 0
+	}
+	switch {
+	case a.f < b.f:
+		return -1
+	case a.f > b.f:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,142 @@
+package private_maprdb_go_client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeEvaluatorDocument() *Document {
+	return MakeDocumentFromMap(map[string]interface{}{
+		"_id":     "user1",
+		"name":    "John Smith",
+		"age":     35,
+		"score":   4.5,
+		"active":  true,
+		"code":    []byte{1, 2, 3},
+		"born":    MakeODate(1985, 4, 12),
+		"wakeUp":  MakeOTime(7, 30, 0),
+		"created": MakeOTimestamp(2020, 1, 2, 3, 4, 5, 6),
+		"address": map[string]interface{}{"city": "Boston", "zip": "02101"},
+		"tags":    []interface{}{"a", "b"},
+		"orders": []interface{}{
+			map[string]interface{}{"item": "book", "price": 10},
+			map[string]interface{}{"item": "pen", "price": 2},
+		},
+	})
+}
+
+func TestCondition_Evaluate(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition []ConditionOptions
+		want      bool
+	}{
+		{"equals int and float", []ConditionOptions{Is("age", EQUAL, 35.0)}, true},
+		{"equals missing", []ConditionOptions{Is("missing", EQUAL, 1)}, false},
+		{"not equals missing", []ConditionOptions{Is("missing", NOT_EQUAL, 1)}, true},
+		{"less", []ConditionOptions{Is("score", LESS, 5)}, true},
+		{"less or equal", []ConditionOptions{Is("age", LESS_OR_EQUAL, 35)}, true},
+		{"greater string", []ConditionOptions{Is("name", GREATER, "Jane")}, true},
+		{"greater or equal", []ConditionOptions{Is("age", GREATER_OR_EQUAL, 36)}, false},
+		{"compare different types", []ConditionOptions{Is("name", LESS, 100)}, false},
+		{"nested field", []ConditionOptions{Equals("address.city", "Boston")}, true},
+		{"array index", []ConditionOptions{Equals("tags[1]", "b")}, true},
+		{"any array element", []ConditionOptions{Equals("orders[].item", "pen")}, true},
+		{"binary", []ConditionOptions{Is("code", GREATER, []byte{1, 2})}, true},
+		{"date", []ConditionOptions{Is("born", LESS, MakeODate(1990, 1, 1))}, true},
+		{"time", []ConditionOptions{Is("wakeUp", GREATER_OR_EQUAL, MakeOTime(7, 30, 0))}, true},
+		{"timestamp", []ConditionOptions{Is("created", GREATER, MakeOTimestamp(2020, 1, 2, 3, 4, 5, 7))}, false},
+		{"equals map", []ConditionOptions{Equals("address", map[string]interface{}{"zip": "02101", "city": "Boston"})}, true},
+		{"exists", []ConditionOptions{Exists("address.zip")}, true},
+		{"not exists", []ConditionOptions{NotExists("address.zip")}, false},
+		{"in", []ConditionOptions{In("age", []interface{}{30, 35})}, true},
+		{"not in", []ConditionOptions{NotIn("age", []interface{}{30, 35})}, false},
+		{"typeof", []ConditionOptions{TypeOf("born", "date")}, true},
+		{"typeof code", []ConditionOptions{TypeOf("tags", 17)}, true},
+		{"not typeof", []ConditionOptions{NotTypeOf("score", "double")}, false},
+		{"matches", []ConditionOptions{Matches("name", "J.*h")}, true},
+		{"matches whole string", []ConditionOptions{Matches("name", "John")}, false},
+		{"not matches", []ConditionOptions{NotMatches("name", "J.*")}, false},
+		{"like", []ConditionOptions{Like("name", "J_hn%")}, true},
+		{"like with escape", []ConditionOptions{Like("address.zip", "0#_%", "#")}, false},
+		{"not like", []ConditionOptions{NotLike("name", "%Doe")}, true},
+		{"and", []ConditionOptions{And(), Is("age", GREATER, 30), Equals("active", true), Close()}, true},
+		{"or", []ConditionOptions{Or(), Is("age", GREATER, 40), Equals("active", false), Close()}, false},
+		{"nested or", []ConditionOptions{
+			And(), Exists("name"), Or(), Is("age", GREATER, 40), Equals("tags[0]", "a"), Close(), Close(),
+		}, true},
+		{"element and", []ConditionOptions{
+			ElementAnd("orders[]"), Equals("item", "pen"), Is("price", LESS, 5), Close(),
+		}, true},
+		{"element and different elements", []ConditionOptions{
+			ElementAnd("orders[]"), Equals("orders[].item", "pen"), Is("orders[].price", GREATER, 5), Close(),
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := MakeCondition(append(tt.condition, Close())...)
+			assert.Nil(t, err)
+			condition, err = condition.Build()
+			if !assert.Nil(t, err) {
+				return
+			}
+			got, err := condition.Evaluate(makeEvaluatorDocument())
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEvaluateConditionMap(t *testing.T) {
+	doc := makeEvaluatorDocument()
+	got, err := EvaluateConditionMap(map[string]interface{}{}, doc)
+	assert.Nil(t, err)
+	assert.True(t, got)
+	got, err = EvaluateConditionMap(map[string]interface{}{
+		"$eq": map[string]interface{}{"_id": "user1", "age": 35},
+		"$lt": map[string]interface{}{"score": 5},
+	}, doc)
+	assert.Nil(t, err)
+	assert.True(t, got)
+
+	_, err = EvaluateConditionMap(map[string]interface{}{"$unknown": map[string]interface{}{"a": 1}}, doc)
+	assert.NotNil(t, err)
+	_, err = EvaluateConditionMap(map[string]interface{}{"$in": map[string]interface{}{"age": 35}}, doc)
+	assert.NotNil(t, err)
+	_, err = EvaluateConditionMap(map[string]interface{}{"$typeof": map[string]interface{}{"age": "unknown"}}, doc)
+	assert.NotNil(t, err)
+	_, err = EvaluateConditionMap(map[string]interface{}{"$exists": "a[x]"}, doc)
+	assert.NotNil(t, err)
+
+	condition, err := MakeCondition(Exists("name"), Close())
+	assert.Nil(t, err)
+	_, err = condition.Evaluate(doc)
+	assert.NotNil(t, err)
+}
+
+func TestCompareValues(t *testing.T) {
+	tests := []struct {
+		name string
+		a    interface{}
+		b    interface{}
+		want int
+	}{
+		{"int and float", 1, 1.5, -1},
+		{"large ints", int64(1<<62 + 1), int64(1 << 62), 1},
+		{"equal numbers", int32(2), 2.0, 0},
+		{"null first", nil, false, -1},
+		{"string before number", "z", 1, -1},
+		{"number before date", 100, MakeODate(2000, 1, 1), -1},
+		{"binary after timestamp", []byte{0}, MakeOTimestamp(2000, 1, 1, 0, 0, 0, 0), 1},
+		{"arrays", []interface{}{1, 2}, []interface{}{1, 3}, -1},
+		{"array prefix", []interface{}{1}, []interface{}{1, 0}, -1},
+		{"maps", map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1.0}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompareValues(tt.a, tt.b))
+			assert.Equal(t, -tt.want, CompareValues(tt.b, tt.a))
+		})
+	}
+}
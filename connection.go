@@ -2,38 +2,44 @@ package private_maprdb_go_client
 
 import (
 	"context"
-	"crypto/tls"
-	b64 "encoding/base64"
 	"errors"
 	"fmt"
 	"github.com/grpc-ecosystem/go-grpc-middleware"
-	"github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/metadata"
+	"log/slog"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 type Connection struct {
-	stub    MapRDbServerClient
-	umd     userMetadata
-	channel *grpc.ClientConn
-	opts    *ConnectionOptions
+	stub        MapRDbServerClient
+	auth        AuthProvider
+	pool        *gatewayPool
+	callTimeout time.Duration
+	logger      Logger
+	health      *connectionHealth
+	done        chan struct{}
+	closeOnce   sync.Once
+	pinned      bool
 }
 
 // ConnectionOptions apply to all calls for the connections
 // MaxAttempt attempt count
 // WaitBetweenSeconds delay between attempts in seconds
 // CallTimeoutSeconds maximum call timeout
+// DialOptions additional gRPC dial options, e.g. custom dialer for in-process server
+// TLS parameters of TLS connection, e.g. client certificate for mutual TLS
 type ConnectionOptions struct {
 	MaxAttempt         int
 	WaitBetweenSeconds int
 	CallTimeoutSeconds int
+	DialOptions        []grpc.DialOption
+	TLS                *TLSOptions
 }
 
 var prefix = "ojai:mapr@"
@@ -44,82 +50,88 @@ var prefix = "ojai:mapr@"
 // CallTimeoutSeconds 60
 var defaultConnectionOpts = &ConnectionOptions{MaxAttempt: 9, WaitBetweenSeconds: 12, CallTimeoutSeconds: 60}
 
-// Method creates channel for secure or insecure connection according to input parameters in connection string.
-func createChannel(encodedUMD *string, connectionUrl *string,
-	ssl *bool,
-	sslValidate *bool,
-	sslCA *string,
-	sslTargetNameOverride *string,
-	conOpts *ConnectionOptions) (*Connection, error) {
+// Method creates channel for secure or insecure connection according to connection parameters.
+func createChannel(config *connectionConfig) (*Connection, error) {
+	authProvider, err := config.authProvider()
+	if err != nil {
+		return nil, err
+	}
 	var opts []grpc.DialOption
-	if !*ssl {
+	if !config.ssl.ssl && config.tls == nil {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	} else if *sslValidate {
-		transportCredentials, err := credentials.NewClientTLSFromFile(*sslCA, *sslTargetNameOverride)
+	} else {
+		tlsConfig, err := makeTLSConfig(config.ssl, config.tls)
 		if err != nil {
 			return nil, err
 		}
-		opts = append(opts, grpc.WithTransportCredentials(transportCredentials))
-	} else {
-		var tlsConf tls.Config
-		tlsConf.InsecureSkipVerify = true
-		var creds = credentials.NewTLS(&tlsConf)
-		opts = append(opts, grpc.WithTransportCredentials(creds))
-	}
-	conn := &Connection{umd: userMetadata{*encodedUMD, ""}}
-	if conOpts == nil || conOpts.MaxAttempt < 1 || conOpts.WaitBetweenSeconds < 1 || conOpts.CallTimeoutSeconds < 1 {
-		conn.opts = defaultConnectionOpts
-	} else {
-		conn.opts = conOpts
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	}
-	retryOpts := []grpc_retry.CallOption{
-		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(time.Duration(conn.opts.WaitBetweenSeconds) * time.Second)),
-		grpc_retry.WithCodes(
-			codes.NotFound,
-			codes.Unavailable),
-		grpc_retry.WithPerRetryTimeout(time.Duration(conn.opts.WaitBetweenSeconds) * time.Second),
-		grpc_retry.WithMax(uint(conn.opts.MaxAttempt)),
+	opts = append(opts, config.dialOptions...)
+	conn := &Connection{
+		auth:        authProvider,
+		callTimeout: config.callTimeout,
+		logger:      makeLogger(config.logger),
+		done:        make(chan struct{}),
 	}
-
+	var onStateChange func(previous, current ConnectionState)
+	if config.healthCheck != nil {
+		onStateChange = config.healthCheck.OnStateChange
+	}
+	conn.health = makeConnectionHealth(onStateChange, conn.logger)
+	unaryInterceptors := append([]grpc.UnaryClientInterceptor{}, config.unaryInterceptors...)
+	streamInterceptors := append([]grpc.StreamClientInterceptor{}, config.streamInterceptors...)
+	retryObservers := append([]func(ctx context.Context, attempt RetryAttempt){}, config.retryObservers...)
+	if len(config.authObservers) != 0 {
+		authProvider = &observedAuthProvider{AuthProvider: authProvider, observers: config.authObservers}
+	}
+	if config.logger != nil {
+		unaryInterceptors = append(unaryInterceptors, UnaryClientLoggingInterceptor(conn.logger))
+		streamInterceptors = append(streamInterceptors, StreamClientLoggingInterceptor(conn.logger))
+		authProvider = &loggedAuthProvider{AuthProvider: authProvider, logger: conn.logger}
+		retryObservers = append(retryObservers, logRetry(conn.logger))
+	}
+	retryPolicy := *config.retryPolicy
+	retryPolicy.observer = func(ctx context.Context, attempt RetryAttempt) {
+		for _, observer := range retryObservers {
+			observer(ctx, attempt)
+		}
+	}
+	unaryInterceptors = append(unaryInterceptors,
+		UnaryClientAuthInterceptor(authProvider),
+		UnaryClientRetryInterceptor(&retryPolicy),
+	)
+	streamInterceptors = append(streamInterceptors,
+		StreamClientAuthInterceptor(authProvider),
+		StreamClientRetryInterceptor(&retryPolicy),
+	)
 	opts = append(
 		opts,
-		grpc.WithUnaryInterceptor(
-			grpc_middleware.ChainUnaryClient(
-				UnaryClientAuthInterceptor(&conn.umd),
-				UnaryClientTokenInterceptor(&conn.umd),
-				grpc_retry.UnaryClientInterceptor(retryOpts...),
-			)),
-		grpc.WithStreamInterceptor(
-			grpc_middleware.ChainStreamClient(
-				StreamClientAuthInterceptor(&conn.umd),
-				StreamClientTokenInterceptor(&conn.umd),
-				grpc_retry.StreamClientInterceptor(retryOpts...),
-			)),
+		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(unaryInterceptors...)),
+		grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(streamInterceptors...)),
 	)
-	channel, err := grpc.Dial(*connectionUrl, opts...)
+	dial := func(target string) (*grpc.ClientConn, error) {
+		return grpc.Dial(target, opts...)
+	}
+	conn.pool, err = makeGatewayPool(config.targets(), dial, config.loadBalancing, retryPolicy.RetryNonIdempotent, conn.logger)
 	if err != nil {
 		return nil, err
 	}
-	conn.channel = channel
 	return conn, nil
 }
 
 // Method pings gRPC server for ensure that connection is established
-func pingRequest(connection *Connection) error {
-	header := make(metadata.MD)
-	trailer := make(metadata.MD)
-	ctx, cancel := context.WithTimeout(context.Background(),
-		time.Duration(connection.opts.CallTimeoutSeconds)*time.Second)
+func pingRequest(ctx context.Context, connection *Connection) error {
+	ctx, cancel := context.WithTimeout(ctx, connection.callTimeout)
 	defer cancel()
+	start := time.Now()
 	_, err := connection.stub.Ping(ctx,
 		&PingRequest{},
-		grpc.Header(&header),
-		grpc.Trailer(&trailer),
 	)
 	if err != nil {
-		return err
+		connection.logger.Log(ctx, slog.LevelWarn, "ping failed", "error", err)
+		return wrapRpcError(err)
 	}
-	connection.umd.UpdateToken(header, trailer)
+	connection.logger.Log(ctx, slog.LevelDebug, "ping succeeded", "duration", time.Since(start))
 	return nil
 }
 
@@ -133,49 +145,37 @@ func (connection *Connection) GetStore(storeName string) (*DocumentStore, error)
 	if res {
 		return &DocumentStore{connection: connection, storeName: storeName}, nil
 	} else {
-		return nil, errors.New(fmt.Sprintf("store %v not found", storeName))
+		return nil, &OjaiError{Code: ErrorCode_TABLE_NOT_FOUND, Message: fmt.Sprintf("store %v not found", storeName)}
 	}
 }
 
 // Method executes TableExists RPC request with given store name and return true if table is exists or false if not.
 func (connection *Connection) IsStoreExists(storeName string) (bool, error) {
-	header := make(metadata.MD)
-	trailer := make(metadata.MD)
-	ctx, cancel := context.WithTimeout(context.Background(),
-		time.Duration(connection.opts.CallTimeoutSeconds)*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), connection.callTimeout)
 	defer cancel()
 
 	response, err := connection.stub.TableExists(ctx,
-		&TableExistsRequest{TablePath: storeName},
-		grpc.Header(&header),
-		grpc.Trailer(&trailer))
+		&TableExistsRequest{TablePath: storeName})
 	if err != nil {
-		return false, errors.New(fmt.Sprintf("couldn't execute request: %v", err))
+		return false, wrapRpcError(err)
 	}
-	connection.umd.UpdateToken(header, trailer)
 	return checkExistsErrorCode(response.GetError())
 }
 
 // Method executes DeleteTable RPC request with given store name.
 func (connection *Connection) DeleteStore(storeName string) error {
-	header := make(metadata.MD)
-	trailer := make(metadata.MD)
-	ctx, cancel := context.WithTimeout(context.Background(),
-		time.Duration(connection.opts.CallTimeoutSeconds)*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), connection.callTimeout)
 	defer cancel()
 
 	response, err := connection.stub.DeleteTable(ctx,
-		&DeleteTableRequest{TablePath: storeName},
-		grpc.Header(&header),
-		grpc.Trailer(&trailer))
+		&DeleteTableRequest{TablePath: storeName})
 	if err != nil {
-		return err
+		return wrapRpcError(err)
 	}
 	err = checkResponseErrorCode(response.GetError())
 	if err != nil {
 		return err
 	}
-	connection.umd.UpdateToken(header, trailer)
 	return nil
 }
 
@@ -196,130 +196,177 @@ func (connection *Connection) CreateDocumentFromMap(documentMap map[string]inter
 
 // Method executes CreateTable RPC request with given store name and return new DocumentStore.
 func (connection *Connection) CreateStore(storeName string) (*DocumentStore, error) {
-	header := make(metadata.MD)
-	trailer := make(metadata.MD)
-	ctx, cancel := context.WithTimeout(context.Background(),
-		time.Duration(connection.opts.CallTimeoutSeconds)*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), connection.callTimeout)
 	defer cancel()
 
 	response, err := connection.stub.CreateTable(ctx,
-		&CreateTableRequest{TablePath: storeName},
-		grpc.Header(&header),
-		grpc.Trailer(&trailer))
+		&CreateTableRequest{TablePath: storeName})
 	if err != nil {
-		return nil, errors.New(fmt.Sprintf("couldn't execute request: %v", err))
+		return nil, wrapRpcError(err)
 	}
 	err = checkResponseErrorCode(response.GetError())
 	if err != nil {
 		return nil, err
 	}
-	connection.umd.UpdateToken(header, trailer)
 	return connection.GetStore(storeName)
 }
 
-// Method checks response error code.
+// Method checks response error code and returns OjaiError if it is not NO_ERROR.
 func checkResponseErrorCode(rpcError *RpcError) error {
-	switch rpcError.ErrCode {
-	case ErrorCode_NO_ERROR:
-		return nil
-	default:
-		return errors.New(fmt.Sprintf("unexpected error code recieved from server.\n error: %v.\n"+
-			" error message : %v.\n java stacktrace: %v.\n",
-			rpcError.ErrCode.String(),
-			rpcError.ErrorMessage,
-			rpcError.JavaStackTrace))
-	}
+	return newOjaiError(rpcError)
 }
 
 // Method checks IsTableExists  response error code and return true
 // if error code is 0 (NO ERROR), false if error code 2(TABLE NOT FOUND) otherwise error.
 func checkExistsErrorCode(rpcError *RpcError) (bool, error) {
-	switch rpcError.ErrCode {
+	switch rpcError.GetErrCode() {
 	case ErrorCode_NO_ERROR:
 		return true, nil
 	case ErrorCode_TABLE_NOT_FOUND:
 		return false, nil
 	default:
-		return false, errors.New(fmt.Sprintf("unexpected error code recieved from server.\n error: %v.\n"+
-			" error message : %v.\n java stacktrace: %v.\n",
-			rpcError.ErrCode.String(),
-			rpcError.ErrorMessage,
-			rpcError.JavaStackTrace))
+		return false, newOjaiError(rpcError)
 	}
 }
 
-// Method parses input connection string and returns argument or default values.
-func parseConnectionString(connectionString string) (
-	connectionUrl string,
-	auth string,
-	encodedMetadata string,
-	ssl bool,
-	sslValidate bool,
-	sslCA string,
-	sslTargetNameOverride string,
-	err error) {
+// Supported parameters of connection string
+var connectionParameters = []string{
+	"auth", "user", "password", "token", "tokenFile",
+	"ssl", "sslValidate", "sslCA", "sslCAPem", "sslCert", "sslKey", "sslTargetNameOverride", "loadBalancing",
+}
+
+// Method parses input connection string and returns connection parameters with default values of missing ones.
+func parseConnectionString(connectionString string) (*connectionConfig, error) {
 	u, err := url.Parse(connectionString)
 	if err != nil {
 		connectionString = prefix + connectionString
 		u, err = url.Parse(connectionString)
 		if err != nil {
-			return
+			return nil, fmt.Errorf("invalid connection string: %v", err)
 		}
 	}
-	mapValues := parseQuery(u.RawQuery)
-	connectionUrl = findHost(fmt.Sprintf("%v:%v", u.Scheme, u.Opaque))
-	auth = getValueOrDefault(mapValues, "auth", "basic")
-	user := getValueOrDefault(mapValues, "user", "")
-	password := getValueOrDefault(mapValues, "password", "")
-	encodedMetadata = b64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%v:%v", user, password)))
-	sslDecoded := getValueOrDefault(mapValues, "ssl", "false")
-
-	ssl, err = strconv.ParseBool(sslDecoded)
+	mapValues, err := parseQuery(u.RawQuery)
 	if err != nil {
-		return
+		return nil, err
 	}
-
-	sslValidateDecoded := getValueOrDefault(mapValues, "sslValidate", "true")
-	sslValidate, err = strconv.ParseBool(sslValidateDecoded)
-	if err != nil {
-		return
+	config := makeConnectionConfig()
+	config.target = findHost(fmt.Sprintf("%v:%v", u.Scheme, u.Opaque))
+	if len(strings.Trim(config.target, ":")) == 0 {
+		return nil, errors.New("connection string doesn't contain host")
 	}
-
-	sslCA = getValueOrDefault(mapValues, "sslCA", "")
-	sslTargetNameOverride = getValueOrDefault(mapValues, "sslTargetNameOverride", "")
-	//TODO add value validation before return
-	return
+	for _, target := range config.targets() {
+		if len(strings.Trim(target, ": ")) == 0 {
+			return nil, fmt.Errorf("connection string contains empty host in '%v'", config.target)
+		}
+	}
+	config.authParams = authParameters{
+		auth:      getValueOrDefault(mapValues, "auth", "basic"),
+		user:      getValueOrDefault(mapValues, "user", ""),
+		password:  getValueOrDefault(mapValues, "password", ""),
+		token:     getValueOrDefault(mapValues, "token", ""),
+		tokenFile: getValueOrDefault(mapValues, "tokenFile", ""),
+	}
+	switch config.authParams.auth {
+	case "basic", "jwt", "jwtFile":
+	default:
+		return nil, fmt.Errorf("invalid value '%v' of connection string parameter 'auth', expected one of basic, jwt, jwtFile",
+			config.authParams.auth)
+	}
+	if config.ssl.ssl, err = parseBoolParameter(mapValues, "ssl", false); err != nil {
+		return nil, err
+	}
+	if config.ssl.validate, err = parseBoolParameter(mapValues, "sslValidate", true); err != nil {
+		return nil, err
+	}
+	config.ssl.ca = getValueOrDefault(mapValues, "sslCA", "")
+	config.ssl.caPem = getValueOrDefault(mapValues, "sslCAPem", "")
+	config.ssl.cert = getValueOrDefault(mapValues, "sslCert", "")
+	config.ssl.key = getValueOrDefault(mapValues, "sslKey", "")
+	config.ssl.targetNameOverride = getValueOrDefault(mapValues, "sslTargetNameOverride", "")
+	if !config.ssl.ssl && (config.ssl.caPem != "" || config.ssl.cert != "" || config.ssl.key != "") {
+		return nil, errors.New("connection string parameters 'sslCAPem', 'sslCert' and 'sslKey' require 'ssl=true'")
+	}
+	if config.loadBalancing, err = parseLoadBalancingPolicy(getValueOrDefault(mapValues, "loadBalancing",
+		RoundRobin.String())); err != nil {
+		return nil, err
+	}
+	return config, nil
 }
 
-// find host or host:port in connection string opaque value
+// find host or host:port in connection string opaque value, several hosts are separated by comma,
+// e.g. ojai:mapr@host1:5678,host2:5678 or ojai:mapr:host1:5678,host2:5678
 func findHost(unparsedString string) string {
 	parsedString := strings.Split(unparsedString, "@")
+	if len(parsedString) == 1 {
+		return strings.TrimPrefix(unparsedString, strings.TrimSuffix(prefix, "@")+":")
+	}
 	return parsedString[len(parsedString)-1]
 }
 
-func parseQuery(unparsedString string) url.Values {
+// parseQuery parses key=value parameters separated by semicolon and unescapes values
+func parseQuery(unparsedString string) (url.Values, error) {
 	items := strings.Split(unparsedString, ";")
 
 	// create and fill the map
 	valuesMap := make(url.Values)
 	for _, item := range items {
-		value := strings.Split(item, "=")
-		valuesMap[value[0]] = append(valuesMap[value[0]], value[1])
+		if len(item) == 0 {
+			continue
+		}
+		value := strings.SplitN(item, "=", 2)
+		if len(value) != 2 || len(value[0]) == 0 {
+			return nil, fmt.Errorf("invalid connection string parameter '%v', expected key=value", value[0])
+		}
+		if !isConnectionParameter(value[0]) {
+			return nil, fmt.Errorf("unknown connection string parameter '%v', supported parameters are %v",
+				value[0], strings.Join(connectionParameters, ", "))
+		}
+		if _, ok := valuesMap[value[0]]; ok {
+			return nil, fmt.Errorf("duplicate connection string parameter '%v'", value[0])
+		}
+		decodedValue, err := url.QueryUnescape(value[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid escaping of connection string parameter '%v': %v", value[0], err)
+		}
+		valuesMap[value[0]] = []string{decodedValue}
 	}
 
-	return valuesMap
+	return valuesMap, nil
+}
+
+// isConnectionParameter checks whether key is supported parameter of connection string
+func isConnectionParameter(key string) bool {
+	for _, parameter := range connectionParameters {
+		if parameter == key {
+			return true
+		}
+	}
+	return false
 }
 
 // method fetches value from url.Values or returns default value
 func getValueOrDefault(content url.Values, key string, defaultValue string) string {
 	if val, ok := content[key]; ok {
-		decodedValue, _ := url.QueryUnescape(val[0])
-		return decodedValue
+		return val[0]
 	} else {
 		return defaultValue
 	}
 }
 
+// parseBoolParameter fetches boolean value from url.Values or returns default value
+func parseBoolParameter(content url.Values, key string, defaultValue bool) (bool, error) {
+	val, ok := content[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	value, err := strconv.ParseBool(val[0])
+	if err != nil {
+		return false, fmt.Errorf("invalid value '%v' of connection string parameter '%v', expected true or false",
+			val[0], key)
+	}
+	return value, nil
+}
+
 // Function initialize connection and returns new Connection struct
 func MakeConnection(connectionString string) (*Connection, error) {
 	return MakeConnectionWithRetryOptions(connectionString, nil)
@@ -330,34 +377,83 @@ func MakeConnectionWithRetryOptions(
 	connectionString string,
 	connectionOptions *ConnectionOptions,
 ) (*Connection, error) {
-	connectionUrl, auth, encodedMetadata,
-		ssl, sslValidate, sslCA, sslTargetNameOverride, err := parseConnectionString(connectionString)
+	return MakeConnectionWithAuthOptions(connectionString, connectionOptions, nil)
+}
+
+// Function initialize connection with specific retry and authentication options and returns new Connection struct.
+// AuthProvider from authOptions is used instead of authentication parameters of connection string.
+func MakeConnectionWithAuthOptions(
+	connectionString string,
+	connectionOptions *ConnectionOptions,
+	authOptions *AuthOptions,
+) (*Connection, error) {
+	opts := []ConnectionOption{withConnectionOptions(connectionOptions)}
+	if authOptions != nil && authOptions.Provider != nil {
+		opts = append(opts, WithAuthProvider(authOptions.Provider))
+	}
+	return NewConnection(context.Background(), connectionString, opts...)
+}
+
+// NewConnection initialize connection to the target and returns new Connection struct.
+// Target is a connection string, e.g. "ojai:mapr@host:5678?auth=basic;user=mapr;password=mapr",
+// options take precedence over parameters of connection string.
+// Connection is checked with Ping request which is cancelled when ctx is done.
+func NewConnection(ctx context.Context, target string, opts ...ConnectionOption) (*Connection, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	config, err := parseConnectionString(target)
 	if err != nil {
 		return nil, err
 	}
-	if auth != "basic" {
-		return nil, errors.New("currently server supports only 'basic' authentication")
-	}
-	connection, err := createChannel(
-		&encodedMetadata,
-		&connectionUrl,
-		&ssl,
-		&sslValidate,
-		&sslCA,
-		&sslTargetNameOverride,
-		connectionOptions)
+	for _, opt := range opts {
+		config, err = opt(config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	connection, err := createChannel(config)
 	if err != nil {
 		return nil, err
 	}
-	connection.stub = NewMapRDbServerClient(connection.channel)
-	err = pingRequest(connection)
+	tls := config.ssl.ssl || config.tls != nil
+	connection.logger.Log(ctx, slog.LevelDebug, "connecting", "target", config.target, "tls", tls)
+	connection.stub = NewMapRDbServerClient(connection.pool)
+	err = pingRequest(ctx, connection)
 	if err != nil {
+		connection.logger.Log(ctx, slog.LevelError, "connection failed", "target", config.target, "error", err)
+		connection.Close()
 		return nil, err
 	}
+	connection.logger.Log(ctx, slog.LevelInfo, "connected", "target", config.target, "tls", tls)
+	connection.health.setState(ConnectionStateReady)
+	if config.healthCheck != nil || len(connection.pool.gateways) > 1 {
+		options := HealthCheckOptions{}
+		if config.healthCheck != nil {
+			options = *config.healthCheck
+		}
+		if options.Interval == 0 {
+			options.Interval = 30 * time.Second
+		}
+		if options.Timeout == 0 {
+			options.Timeout = config.callTimeout
+		}
+		if options.FailureThreshold == 0 {
+			options.FailureThreshold = 1
+		}
+		go connection.monitorHealth(options)
+	}
 	return connection, nil
 }
 
-// Method Close closes gRPC channel.
+// Method Close stops health monitor and closes gRPC channels, it does nothing for pinned connection.
 func (connection *Connection) Close() {
-	defer connection.channel.Close()
+	if connection.pinned {
+		return
+	}
+	connection.closeOnce.Do(func() {
+		connection.health.setState(ConnectionStateClosed)
+		close(connection.done)
+		connection.pool.Close()
+	})
 }
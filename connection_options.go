@@ -0,0 +1,273 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+)
+
+// Authentication parameters of connection string
+type authParameters struct {
+	auth      string
+	user      string
+	password  string
+	token     string
+	tokenFile string
+}
+
+// connectionConfig parameters of Connection from connection string and ConnectionOption functions
+// target host or host:port of the server, several gateways are separated by comma
+// loadBalancing policy of selecting gateway for each call
+// authParams authentication parameters of connection string which are used if auth is not set
+// ssl, tls parameters of TLS connection
+// callTimeout and retryPolicy timeout and retry parameters of calls
+// retryObservers functions which are called before each retry
+// authObservers functions which receive response of every call passed to AuthProvider
+// logger receives events of the connection, they are dropped if it's nil
+// healthCheck parameters of health monitor, it isn't started if it's nil
+// dialOptions, unaryInterceptors and streamInterceptors additional gRPC parameters
+type connectionConfig struct {
+	target             string
+	loadBalancing      LoadBalancingPolicy
+	authParams         authParameters
+	auth               AuthProvider
+	ssl                *sslParameters
+	tls                *TLSOptions
+	callTimeout        time.Duration
+	retryPolicy        *RetryPolicy
+	retryObservers     []func(ctx context.Context, attempt RetryAttempt)
+	authObservers      []func(authorization string, header metadata.MD, err error)
+	logger             Logger
+	healthCheck        *HealthCheckOptions
+	dialOptions        []grpc.DialOption
+	unaryInterceptors  []grpc.UnaryClientInterceptor
+	streamInterceptors []grpc.StreamClientInterceptor
+}
+
+// ConnectionOption sets parameter of Connection created by NewConnection
+type ConnectionOption func(config *connectionConfig) (*connectionConfig, error)
+
+// makeConnectionConfig returns connectionConfig with default parameters
+func makeConnectionConfig() *connectionConfig {
+	return &connectionConfig{
+		ssl:         &sslParameters{validate: true},
+		callTimeout: time.Duration(defaultConnectionOpts.CallTimeoutSeconds) * time.Second,
+		retryPolicy: DefaultRetryPolicy(),
+	}
+}
+
+// targets method returns host or host:port of every gateway
+func (config *connectionConfig) targets() []string {
+	targets := strings.Split(config.target, ",")
+	for i := range targets {
+		targets[i] = strings.TrimSpace(targets[i])
+	}
+	return targets
+}
+
+// authProvider method returns AuthProvider set by option or created from connection string parameters
+func (config *connectionConfig) authProvider() (AuthProvider, error) {
+	if config.auth != nil {
+		return config.auth, nil
+	}
+	return makeAuthProvider(
+		config.authParams.auth,
+		config.authParams.user,
+		config.authParams.password,
+		config.authParams.token,
+		config.authParams.tokenFile)
+}
+
+// WithCredentials sets user name and password for basic authentication
+func WithCredentials(user, password string) ConnectionOption {
+	return WithAuthProvider(MakeBasicAuthProvider(user, password))
+}
+
+// WithAuthProvider sets AuthProvider which is used instead of authentication parameters of connection string
+func WithAuthProvider(provider AuthProvider) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if provider == nil {
+			return nil, errors.New("auth provider can't be nil")
+		}
+		config.auth = provider
+		return config, nil
+	}
+}
+
+// WithTLS enables TLS with given parameters in addition to SSL parameters of connection string
+func WithTLS(options *TLSOptions) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if options == nil {
+			return nil, errors.New("TLS options can't be nil")
+		}
+		config.tls = options
+		return config, nil
+	}
+}
+
+// WithCallTimeout sets maximum duration of each call, 60 seconds by default
+func WithCallTimeout(timeout time.Duration) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if timeout <= 0 {
+			return nil, errors.New("call timeout must be positive")
+		}
+		config.callTimeout = timeout
+		return config, nil
+	}
+}
+
+// WithRetry sets attempt count and constant delay between attempts of calls which failed with
+// NotFound or Unavailable codes, delay is also used as timeout of each attempt
+func WithRetry(maxAttempt int, waitBetween time.Duration) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if maxAttempt < 1 || waitBetween <= 0 {
+			return nil, errors.New("retry attempt count and delay must be positive")
+		}
+		config.retryPolicy = linearRetryPolicy(maxAttempt, waitBetween)
+		return config, nil
+	}
+}
+
+// WithRetryPolicy sets RetryPolicy of calls, DefaultRetryPolicy is used by default
+func WithRetryPolicy(retryPolicy *RetryPolicy) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if retryPolicy == nil {
+			return nil, errors.New("retry policy can't be nil")
+		}
+		if err := retryPolicy.validate(); err != nil {
+			return nil, err
+		}
+		policy := *retryPolicy
+		config.retryPolicy = &policy
+		return config, nil
+	}
+}
+
+// WithRetryObserver adds function which is called with context of the call before each retry,
+// e.g. to record retries in the span of the call
+func WithRetryObserver(observer func(ctx context.Context, attempt RetryAttempt)) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if observer == nil {
+			return nil, errors.New("retry observer can't be nil")
+		}
+		config.retryObservers = append(config.retryObservers, observer)
+		return config, nil
+	}
+}
+
+// WithAuthObserver adds function which receives authorization value, response header and error of every call
+// like AuthProvider.Done, e.g. to count tokens issued by server
+func WithAuthObserver(observer func(authorization string, header metadata.MD, err error)) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if observer == nil {
+			return nil, errors.New("auth observer can't be nil")
+		}
+		config.authObservers = append(config.authObservers, observer)
+		return config, nil
+	}
+}
+
+// WithLogger sets Logger of connection events, e.g. *slog.Logger, passwords and tokens are redacted
+func WithLogger(logger Logger) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if logger == nil {
+			return nil, errors.New("logger can't be nil")
+		}
+		config.logger = logger
+		return config, nil
+	}
+}
+
+// WithHealthCheck starts background health monitor which pings the server and dials it again
+// after persistent failures, state of the connection is reported by Connection.State
+func WithHealthCheck(options *HealthCheckOptions) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if options == nil {
+			return nil, errors.New("health check options can't be nil")
+		}
+		if options.Interval < 0 || options.Timeout < 0 || options.FailureThreshold < 0 || options.ReconnectThreshold < 0 {
+			return nil, errors.New("health check parameters can't be negative")
+		}
+		config.healthCheck = options
+		return config, nil
+	}
+}
+
+// WithLoadBalancing sets policy of selecting gateway if connection string contains several hosts,
+// it overrides loadBalancing parameter of connection string
+func WithLoadBalancing(policy LoadBalancingPolicy) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		if policy != RoundRobin && policy != LeastLoaded {
+			return nil, fmt.Errorf("unknown load balancing policy %v", int(policy))
+		}
+		config.loadBalancing = policy
+		return config, nil
+	}
+}
+
+// WithDialOptions adds gRPC dial options, e.g. custom dialer for in-process server
+func WithDialOptions(opts ...grpc.DialOption) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		config.dialOptions = append(config.dialOptions, opts...)
+		return config, nil
+	}
+}
+
+// WithUnaryInterceptors adds interceptors of unary calls which are called before authentication and retry
+func WithUnaryInterceptors(interceptors ...grpc.UnaryClientInterceptor) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		config.unaryInterceptors = append(config.unaryInterceptors, interceptors...)
+		return config, nil
+	}
+}
+
+// WithStreamInterceptors adds interceptors of stream calls which are called before authentication and retry
+func WithStreamInterceptors(interceptors ...grpc.StreamClientInterceptor) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		config.streamInterceptors = append(config.streamInterceptors, interceptors...)
+		return config, nil
+	}
+}
+
+// WithUserAgent sets user agent which is sent with every call
+func WithUserAgent(userAgent string) ConnectionOption {
+	return WithDialOptions(grpc.WithUserAgent(userAgent))
+}
+
+// WithKeepalive sets keepalive parameters of the connection
+func WithKeepalive(params keepalive.ClientParameters) ConnectionOption {
+	return WithDialOptions(grpc.WithKeepaliveParams(params))
+}
+
+// withConnectionOptions applies ConnectionOptions of MakeConnection functions, retry and timeout values
+// of defaultConnectionOpts are used if options are nil or any of the values is invalid, so retries
+// keep constant delay of these functions instead of backoff of DefaultRetryPolicy
+func withConnectionOptions(connectionOptions *ConnectionOptions) ConnectionOption {
+	return func(config *connectionConfig) (*connectionConfig, error) {
+		retryOptions := connectionOptions
+		if retryOptions == nil ||
+			retryOptions.MaxAttempt < 1 ||
+			retryOptions.WaitBetweenSeconds < 1 ||
+			retryOptions.CallTimeoutSeconds < 1 {
+			retryOptions = defaultConnectionOpts
+		}
+		config.retryPolicy = linearRetryPolicy(
+			retryOptions.MaxAttempt,
+			time.Duration(retryOptions.WaitBetweenSeconds)*time.Second)
+		config.callTimeout = time.Duration(retryOptions.CallTimeoutSeconds) * time.Second
+		if connectionOptions == nil {
+			return config, nil
+		}
+		config.dialOptions = append(config.dialOptions, connectionOptions.DialOptions...)
+		if connectionOptions.TLS != nil {
+			config.tls = connectionOptions.TLS
+		}
+		return config, nil
+	}
+}
@@ -0,0 +1,111 @@
+package private_maprdb_go_client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConnectionString(t *testing.T) {
+	tests := []struct {
+		name             string
+		connectionString string
+		wantTarget       string
+		wantAuth         authParameters
+		wantErr          string
+	}{
+		{"without parameters", "localhost:5678", "localhost:5678", authParameters{auth: "basic"}, ""},
+		{"with prefix", "ojai:mapr@localhost:5678?auth=basic;user=mapr;password=p%3Bw%3D", "localhost:5678",
+			authParameters{auth: "basic", user: "mapr", password: "p;w="}, ""},
+		{"trailing separator", "localhost:5678?user=mapr;", "localhost:5678",
+			authParameters{auth: "basic", user: "mapr"}, ""},
+		{"value with equal sign", "localhost:5678?auth=jwt;token=abc==", "localhost:5678",
+			authParameters{auth: "jwt", token: "abc=="}, ""},
+		{"item without value", "localhost:5678?user", "", authParameters{},
+			"invalid connection string parameter 'user', expected key=value"},
+		{"empty key", "localhost:5678?=mapr", "", authParameters{},
+			"invalid connection string parameter '', expected key=value"},
+		{"unknown key", "localhost:5678?usr=mapr", "", authParameters{},
+			"unknown connection string parameter 'usr', supported parameters are auth, user, password, token, " +
+				"tokenFile, ssl, sslValidate, sslCA, sslCAPem, sslCert, sslKey, sslTargetNameOverride, loadBalancing"},
+		{"duplicate key", "localhost:5678?user=a;user=b", "", authParameters{},
+			"duplicate connection string parameter 'user'"},
+		{"invalid escaping", "localhost:5678?password=%zz", "", authParameters{},
+			"invalid escaping of connection string parameter 'password': invalid URL escape \"%zz\""},
+		{"invalid bool", "localhost:5678?ssl=yes", "", authParameters{},
+			"invalid value 'yes' of connection string parameter 'ssl', expected true or false"},
+		{"invalid auth", "localhost:5678?auth=kerberos", "", authParameters{},
+			"invalid value 'kerberos' of connection string parameter 'auth', expected one of basic, jwt, jwtFile"},
+		{"without host", "ojai:mapr@?user=mapr", "", authParameters{}, "connection string doesn't contain host"},
+		{"several hosts", "ojai:mapr@host1:5678,host2:5678?loadBalancing=leastLoaded", "host1:5678,host2:5678",
+			authParameters{auth: "basic"}, ""},
+		{"several hosts without at sign", "ojai:mapr:host1:5678,host2:5678", "host1:5678,host2:5678",
+			authParameters{auth: "basic"}, ""},
+		{"empty host", "ojai:mapr@host1:5678,,host2:5678", "", authParameters{},
+			"connection string contains empty host in 'host1:5678,,host2:5678'"},
+		{"invalid load balancing", "localhost:5678?loadBalancing=random", "", authParameters{},
+			"invalid value 'random' of connection string parameter 'loadBalancing', expected one of roundRobin, leastLoaded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := parseConnectionString(tt.connectionString)
+			if len(tt.wantErr) != 0 {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantTarget, config.target)
+			assert.Equal(t, tt.wantAuth, config.authParams)
+		})
+	}
+}
+
+func TestConnectionOption(t *testing.T) {
+	config, err := parseConnectionString("localhost:5678")
+	assert.Nil(t, err)
+	assert.Equal(t, 60*time.Second, config.callTimeout)
+	assert.Equal(t, DefaultRetryPolicy(), config.retryPolicy)
+
+	config, err = withConnectionOptions(nil)(config)
+	assert.Nil(t, err)
+	assert.Equal(t, 60*time.Second, config.callTimeout)
+	assert.Equal(t, linearRetryPolicy(9, 12*time.Second), config.retryPolicy)
+
+	for _, opt := range []ConnectionOption{
+		withConnectionOptions(&ConnectionOptions{CallTimeoutSeconds: 5}),
+		WithCallTimeout(1500 * time.Millisecond),
+		WithRetry(3, time.Second),
+		WithTLS(&TLSOptions{UseSystemRoots: true}),
+		WithUserAgent("test"),
+	} {
+		config, err = opt(config)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 1500*time.Millisecond, config.callTimeout)
+	assert.Equal(t, linearRetryPolicy(3, time.Second), config.retryPolicy)
+	assert.True(t, config.tls.UseSystemRoots)
+	assert.Len(t, config.dialOptions, 1)
+
+	policy := &RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond, Multiplier: 2, Jitter: 0.1}
+	config, err = WithRetryPolicy(policy)(config)
+	assert.Nil(t, err)
+	assert.Equal(t, policy, config.retryPolicy)
+
+	for _, opt := range []ConnectionOption{
+		WithCallTimeout(0),
+		WithRetry(0, time.Second),
+		WithRetryPolicy(nil),
+		WithRetryPolicy(&RetryPolicy{MaxAttempts: 3, Multiplier: 0.5}),
+		WithAuthProvider(nil),
+		WithTLS(nil),
+		WithLogger(nil),
+		WithRetryObserver(nil),
+		WithAuthObserver(nil),
+		WithHealthCheck(nil),
+		WithHealthCheck(&HealthCheckOptions{Interval: -time.Second}),
+	} {
+		_, err = opt(config)
+		assert.NotNil(t, err)
+	}
+}
@@ -0,0 +1,130 @@
+package private_maprdb_go_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// OJAI decimal representation, value is unscaled * 10^-scale like in Java BigDecimal.
+// Decimal is immutable, so it can be shared between documents. Zero value of Decimal is 0.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// MakeDecimal creates and returns decimal from unscaled value and scale, e.g. 12345 and 2 for 123.45
+func MakeDecimal(unscaled *big.Int, scale int32) *Decimal {
+	return &Decimal{unscaled: new(big.Int).Set(unscaled), scale: scale}
+}
+
+// MakeDecimalFromInt64 creates and returns decimal with zero scale from int64
+func MakeDecimalFromInt64(value int64) *Decimal {
+	return &Decimal{unscaled: big.NewInt(value)}
+}
+
+// MakeDecimalFromFloat64 creates and returns decimal from the shortest decimal representation of float64
+func MakeDecimalFromFloat64(value float64) (*Decimal, error) {
+	return MakeDecimalFromString(strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// MakeDecimalFromString creates and returns decimal from string in plain or scientific notation.
+// example : "-123.45" or "1.2345e2"
+func MakeDecimalFromString(value string) (*Decimal, error) {
+	digits, exponent := value, int64(0)
+	if index := strings.IndexAny(value, "eE"); index >= 0 {
+		var err error
+		exponent, err = strconv.ParseInt(value[index+1:], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decimal %v", value)
+		}
+		digits = value[:index]
+	}
+	scale := int64(0)
+	if index := strings.IndexByte(digits, '.'); index >= 0 {
+		scale = int64(len(digits) - index - 1)
+		digits = digits[:index] + digits[index+1:]
+	}
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %v", value)
+	}
+	scale -= exponent
+	if scale < -1<<31 || scale > 1<<31-1 {
+		return nil, fmt.Errorf("scale of decimal %v is out of range", value)
+	}
+	return &Decimal{unscaled: unscaled, scale: int32(scale)}, nil
+}
+
+// Unscaled returns copy of the unscaled value of the decimal
+func (decimal *Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(decimal.value())
+}
+
+// Scale returns number of digits after the decimal point, negative scale multiplies unscaled value by power of ten
+func (decimal *Decimal) Scale() int32 {
+	return decimal.scale
+}
+
+// Rat returns exact value of the decimal as big.Rat
+func (decimal *Decimal) Rat() *big.Rat {
+	power := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs32(decimal.scale))), nil)
+	if decimal.scale < 0 {
+		return new(big.Rat).SetInt(new(big.Int).Mul(decimal.value(), power))
+	}
+	return new(big.Rat).SetFrac(decimal.value(), power)
+}
+
+// Float64 returns the nearest float64 value of the decimal
+func (decimal *Decimal) Float64() float64 {
+	value, _ := decimal.Rat().Float64()
+	return value
+}
+
+// Cmp compares decimals by value and returns -1, 0 or 1, e.g. 1.50 and 1.5 are equal
+func (decimal *Decimal) Cmp(other *Decimal) int {
+	return decimal.Rat().Cmp(other.Rat())
+}
+
+// Stringer interface implementation, decimal is written in plain notation with all digits of the scale
+func (decimal *Decimal) String() string {
+	unscaled := decimal.value()
+	digits := new(big.Int).Abs(unscaled).String()
+	sign := ""
+	if unscaled.Sign() < 0 {
+		sign = "-"
+	}
+	if decimal.scale <= 0 {
+		if unscaled.Sign() == 0 {
+			return "0"
+		}
+		return sign + digits + strings.Repeat("0", int(-decimal.scale))
+	}
+	scale := int(decimal.scale)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+// Marshaller implementation for Decimal
+func (decimal *Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(decimal.String())
+}
+
+// value returns unscaled value of the decimal, nil unscaled value of zero Decimal is 0
+func (decimal *Decimal) value() *big.Int {
+	if decimal.unscaled == nil {
+		return new(big.Int)
+	}
+	return decimal.unscaled
+}
+
+func abs32(value int32) int64 {
+	if value < 0 {
+		return -int64(value)
+	}
+	return int64(value)
+}
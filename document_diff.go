@@ -0,0 +1,138 @@
+package private_maprdb_go_client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Diff returns DocumentMutation which turns the old document into the new one, so the update changes only
+// the fields which differ and keeps concurrent changes of other fields. Removed fields are deleted, new and
+// changed fields are set, new fields of an otherwise unchanged nested map are merged into it and elements
+// added to the end of an array are appended. Other changes of an array set a single element or the whole array,
+// whichever needs fewer operations. Fields which change their OJAI type are replaced with $put, since $set
+// can't change the type of an existing field. Documents must have the same _id, the returned mutation is empty
+// if the documents are equal.
+func Diff(oldDocument, newDocument *Document) (*DocumentMutation, error) {
+	if oldDocument == nil || newDocument == nil {
+		return nil, errors.New("documents can't be nil")
+	}
+	oldId, oldHasId := oldDocument.documentMap["_id"]
+	newId, newHasId := newDocument.documentMap["_id"]
+	if oldHasId && newHasId && !equalValues(oldId, newId) {
+		return nil, fmt.Errorf("documents have different _id %v and %v", oldId, newId)
+	}
+	return MakeDocumentMutation(diffMaps(MakeFieldPathFromNames(), oldDocument.documentMap, newDocument.documentMap)...)
+}
+
+// diffMaps returns operations which turn the old map at the field path into the new one
+func diffMaps(path *FieldPath, oldMap, newMap map[string]interface{}) []MutationOperations {
+	keys := make([]string, 0, len(oldMap)+len(newMap))
+	for key := range oldMap {
+		keys = append(keys, key)
+	}
+	for key := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var operations []MutationOperations
+	var added []string
+	for _, key := range keys {
+		if key == "_id" && len(path.segments) == 0 {
+			continue
+		}
+		oldValue, inOld := oldMap[key]
+		newValue, inNew := newMap[key]
+		switch {
+		case !inNew:
+			operations = append(operations, Delete(path.Child(key).String()))
+		case !inOld:
+			added = append(added, key)
+		default:
+			operations = append(operations, diffValues(path.Child(key), oldValue, newValue)...)
+		}
+	}
+	if len(operations) == 0 && len(added) > 1 && len(path.segments) != 0 {
+		merged := make(map[string]interface{}, len(added))
+		for _, key := range added {
+			merged[key] = newMap[key]
+		}
+		return []MutationOperations{MergeMap(path.String(), merged)}
+	}
+	for _, key := range added {
+		operations = append(operations, Set(path.Child(key).String(), newMap[key]))
+	}
+	return operations
+}
+
+// diffArrays returns operations which turn the old array at the field path into the new one
+func diffArrays(path *FieldPath, oldArray, newArray []interface{}) []MutationOperations {
+	if len(newArray) > len(oldArray) && equalValues(oldArray, newArray[:len(oldArray)]) {
+		return []MutationOperations{AppendSlice(path.String(), newArray[len(oldArray):])}
+	}
+	if len(newArray) == len(oldArray) {
+		var operations []MutationOperations
+		for index := range oldArray {
+			operations = append(operations, diffValues(path.element(index), oldArray[index], newArray[index])...)
+		}
+		if len(operations) == 1 {
+			return operations
+		}
+	}
+	return []MutationOperations{Set(path.String(), newArray)}
+}
+
+// diffValues returns operations which turn the old value at the field path into the new one
+func diffValues(path *FieldPath, oldValue, newValue interface{}) []MutationOperations {
+	if equalValues(oldValue, newValue) {
+		return nil
+	}
+	switch o := oldValue.(type) {
+	case map[string]interface{}:
+		if n, ok := newValue.(map[string]interface{}); ok {
+			return diffMaps(path, o, n)
+		}
+	case []interface{}:
+		if n, ok := newValue.([]interface{}); ok {
+			return diffArrays(path, o, n)
+		}
+	}
+	if ojaiTypeName(oldValue) != ojaiTypeName(newValue) {
+		return []MutationOperations{SetOrReplace(path.String(), newValue)}
+	}
+	return []MutationOperations{Set(path.String(), newValue)}
+}
+
+// equalValues checks whether values have the same OJAI type and value, nested maps and arrays are compared
+// element by element, so e.g. int and float64 elements with equal values differ
+func equalValues(a, b interface{}) bool {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for key, value := range av {
+			other, ok := bv[key]
+			if !ok || !equalValues(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for index := range av {
+			if !equalValues(av[index], bv[index]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return ojaiTypeName(a) == ojaiTypeName(b) && CompareValues(a, b) == 0
+	}
+}
@@ -0,0 +1,68 @@
+package private_maprdb_go_client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldJson string
+		newJson string
+		want    map[string]interface{}
+	}{
+		{"equal", `{"_id": "a", "n": 1, "m": {"x": [1, 2]}}`, `{"m": {"x": [1, 2]}, "n": 1, "_id": "a"}`,
+			map[string]interface{}{}},
+		{"set and delete", `{"_id": "a", "n": 1, "s": "x", "gone": true}`, `{"_id": "a", "n": 2, "s": "x", "new": "y"}`,
+			map[string]interface{}{
+				"$set":    []interface{}{map[string]interface{}{"n": int64(2)}, map[string]interface{}{"new": "y"}},
+				"$delete": "gone",
+			}},
+		{"type change", `{"n": 1, "m": {"a": 1}, "z": null}`, `{"n": 1.5, "m": "flat", "z": 0}`,
+			map[string]interface{}{"$put": []interface{}{
+				map[string]interface{}{"m": "flat"}, map[string]interface{}{"n": 1.5}, map[string]interface{}{"z": int64(0)},
+			}}},
+		{"nested", `{"m": {"a": 1, "b": {"c": 1, "d": 2}}}`, `{"m": {"a": 1, "b": {"c": 3}, "e": 4}}`,
+			map[string]interface{}{
+				"$set":    []interface{}{map[string]interface{}{"m.b.c": int64(3)}, map[string]interface{}{"m.e": int64(4)}},
+				"$delete": "m.b.d",
+			}},
+		{"merge", `{"m": {"a": 1}}`, `{"m": {"a": 1, "b": 2, "c": {"d": 3}}}`,
+			map[string]interface{}{"$merge": map[string]interface{}{
+				"m": map[string]interface{}{"b": int64(2), "c": map[string]interface{}{"d": int64(3)}},
+			}}},
+		{"append", `{"tags": ["a"]}`, `{"tags": ["a", "b", "c"]}`,
+			map[string]interface{}{"$append": map[string]interface{}{"tags": []interface{}{"b", "c"}}}},
+		{"array element", `{"items": [{"n": 1}, {"n": 2}]}`, `{"items": [{"n": 1}, {"n": 5}]}`,
+			map[string]interface{}{"$set": map[string]interface{}{"items[1].n": int64(5)}}},
+		{"whole array", `{"tags": ["a", "b", "c"]}`, `{"tags": ["c", "b"]}`,
+			map[string]interface{}{"$set": map[string]interface{}{"tags": []interface{}{"c", "b"}}}},
+		{"quoted names", `{"a.b": {"c": 1}}`, `{"a.b": {}, "d e": 1}`,
+			map[string]interface{}{
+				"$set":    map[string]interface{}{"d e": int64(1)},
+				"$delete": "`a.b`.c",
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldDocument, err := MakeDocumentFromJson(tt.oldJson)
+			assert.Nil(t, err)
+			newDocument, err := MakeDocumentFromJson(tt.newJson)
+			assert.Nil(t, err)
+			mutation, err := Diff(oldDocument, newDocument)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, mutation.mutationMap)
+			assert.Equal(t, len(tt.want) == 0, mutation.IsEmpty())
+		})
+	}
+
+	_, err := Diff(MakeDocumentFromMap(map[string]interface{}{"_id": "a"}),
+		MakeDocumentFromMap(map[string]interface{}{"_id": "b"}))
+	assert.EqualError(t, err, "documents have different _id a and b")
+	_, err = Diff(nil, MakeDocumentFromMap(map[string]interface{}{"_id": "a"}))
+	assert.EqualError(t, err, "documents can't be nil")
+	_, err = Diff(MakeDocumentFromMap(map[string]interface{}{"_id": "a"}), nil)
+	assert.EqualError(t, err, "documents can't be nil")
+}
@@ -2,6 +2,7 @@ package private_maprdb_go_client
 
 import (
 	"errors"
+	"fmt"
 )
 
 type MutationOp int
@@ -50,15 +51,24 @@ func MakeDocumentMutation(mutations ...MutationOperations) (*DocumentMutation, e
 	return mutation, err
 }
 
-// validateFieldPath function validates is field path valid
-func validateFieldPath(fieldPath string) error {
-	if len(fieldPath) == 0 {
-		return errors.New("field path can't be empty")
+// IsEmpty checks whether DocumentMutation has no operations
+func (documentMutation *DocumentMutation) IsEmpty() bool {
+	return len(documentMutation.mutationMap) == 0
+}
+
+// validateFieldPath function validates is field path valid and returns it in canonical form
+func validateFieldPath(fieldPath string) (string, error) {
+	path, err := MakeFieldPath(fieldPath)
+	if err != nil {
+		return "", err
+	}
+	if path.hasAnyIndex() {
+		return "", fmt.Errorf("field path %v with [] index can't be mutated", fieldPath)
 	}
-	if fieldPath == "_id" {
-		return errors.New("_id field cannot be set or updated")
+	if len(path.segments) == 1 && path.segments[0].name == "_id" {
+		return "", errors.New("_id field cannot be set or updated")
 	}
-	return nil
+	return path.String(), nil
 }
 
 // Sets the field at the given fieldPath to given value
@@ -157,7 +167,7 @@ func DecrementFloat64ByOne(fieldPath string) MutationOperations {
 
 func Delete(fieldPath string) MutationOperations {
 	return func(mutation *DocumentMutation) (*DocumentMutation, error) {
-		err := validateFieldPath(fieldPath)
+		fieldPath, err := validateFieldPath(fieldPath)
 		if err != nil {
 			return nil, err
 		}
@@ -169,7 +179,7 @@ func Delete(fieldPath string) MutationOperations {
 // Deletes the field at the given path
 func mutation(fieldPath string, mutationOperation MutationOp, value interface{}) MutationOperations {
 	return func(mutation *DocumentMutation) (*DocumentMutation, error) {
-		err := validateFieldPath(fieldPath)
+		fieldPath, err := validateFieldPath(fieldPath)
 		if err != nil {
 			return nil, err
 		}
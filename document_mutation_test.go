@@ -26,3 +26,19 @@ func TestMultipleMutationOperations(t *testing.T) {
 		"map[$set:[map[a:12] map[b:55]] $put:map[s.o.r:replace] $increment:[map[inc1:2] map[inc2:1] map[inc3:25]] $decrement:map[dec1:5]]",
 		fmt.Sprintf("%v", docMutation.mutationMap))
 }
+
+func TestMutationFieldPaths(t *testing.T) {
+	docMutation, err := MakeDocumentMutation(Set("`a`.b[1]", 1), Delete("first-name"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"$set":    map[string]interface{}{"a.b[1]": 1},
+		"$delete": "first-name",
+	}, docMutation.mutationMap)
+
+	_, err = MakeDocumentMutation(Set("a.", 1))
+	assert.EqualError(t, err, `invalid field path "a.": empty field name at position 2`)
+	_, err = MakeDocumentMutation(Delete("tags[]"))
+	assert.EqualError(t, err, "field path tags[] with [] index can't be mutated")
+	_, err = MakeDocumentMutation(Set("`_id`", 1))
+	assert.EqualError(t, err, "_id field cannot be set or updated")
+}
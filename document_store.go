@@ -5,8 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
 	"time"
 )
 
@@ -18,6 +16,9 @@ type DocumentStore struct {
 type FindOptions struct {
 	IncludeQueryPlan bool
 	ResultAsDocument bool
+	// Buffered reads the whole result by the Find method, so all errors of the stream are returned by it,
+	// otherwise documents stay in the response stream until they are read by QueryResult.Next.
+	Buffered bool
 }
 
 // InsertDocument method inserts Document into the store in MapR-DB.
@@ -608,6 +609,65 @@ func (documentStore *DocumentStore) ReplaceStringWithIdBinaryContext(
 	return documentStore.ReplaceDocumentWithIdBinaryContext(doc, id, ctx)
 }
 
+// InsertStruct method converts struct with `ojai` tags into Document and inserts it into the store in MapR-DB.
+// The struct must contain the field tagged as `ojai:"_id"`.
+// This operation is successful only when the document with the given id doesn't exist.
+func (documentStore *DocumentStore) InsertStruct(value interface{}) error {
+	return documentStore.InsertStructWithContext(value, nil)
+}
+
+// InsertStructWithContext method converts struct with `ojai` tags into Document
+// and inserts it into the store in MapR-DB.
+// User defined context is required for this method.
+// The struct must contain the field tagged as `ojai:"_id"`.
+// This operation is successful only when the document with the given id doesn't exist.
+func (documentStore *DocumentStore) InsertStructWithContext(value interface{}, ctx context.Context) error {
+	doc, err := MakeDocumentFromStruct(value)
+	if err != nil {
+		return err
+	}
+	return documentStore.InsertDocumentWithContext(doc, ctx)
+}
+
+// InsertOrReplaceStruct method converts struct with `ojai` tags into Document
+// and inserts or replaces it in the store in MapR-DB.
+// The struct must contain the field tagged as `ojai:"_id"`.
+func (documentStore *DocumentStore) InsertOrReplaceStruct(value interface{}) error {
+	return documentStore.InsertOrReplaceStructWithContext(value, nil)
+}
+
+// InsertOrReplaceStructWithContext method converts struct with `ojai` tags into Document
+// and inserts or replaces it in the store in MapR-DB.
+// User defined context is required for this method.
+// The struct must contain the field tagged as `ojai:"_id"`.
+func (documentStore *DocumentStore) InsertOrReplaceStructWithContext(value interface{}, ctx context.Context) error {
+	doc, err := MakeDocumentFromStruct(value)
+	if err != nil {
+		return err
+	}
+	return documentStore.InsertOrReplaceDocumentWithContext(doc, ctx)
+}
+
+// ReplaceStruct method converts struct with `ojai` tags into Document and replaces the document in MapR-DB.
+// The struct must contain the field tagged as `ojai:"_id"`.
+// This operation is successful only when the document with the given id exists.
+func (documentStore *DocumentStore) ReplaceStruct(value interface{}) error {
+	return documentStore.ReplaceStructWithContext(value, nil)
+}
+
+// ReplaceStructWithContext method converts struct with `ojai` tags into Document
+// and replaces the document in MapR-DB.
+// User defined context is required for this method.
+// The struct must contain the field tagged as `ojai:"_id"`.
+// This operation is successful only when the document with the given id exists.
+func (documentStore *DocumentStore) ReplaceStructWithContext(value interface{}, ctx context.Context) error {
+	doc, err := MakeDocumentFromStruct(value)
+	if err != nil {
+		return err
+	}
+	return documentStore.ReplaceDocumentWithContext(doc, ctx)
+}
+
 // Atomically evaluates the condition on the given document and if the
 // condition holds true for the document then it atomically replaces the document
 // with the given document.
@@ -697,11 +757,9 @@ func (documentStore *DocumentStore) executeInsertOrReplace(
 	} else {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(context.Background(),
-			time.Duration(documentStore.connection.opts.CallTimeoutSeconds)*time.Second)
+			documentStore.connection.callTimeout)
 		defer cancel()
 	}
-	header := make(metadata.MD)
-	trailer := make(metadata.MD)
 	request := &InsertOrReplaceRequest{
 		TablePath:       documentStore.storeName,
 		InsertMode:      insertMode,
@@ -717,11 +775,9 @@ func (documentStore *DocumentStore) executeInsertOrReplace(
 	}
 	response, err := documentStore.connection.stub.InsertOrReplace(ctx,
 		request,
-		grpc.Header(&header),
-		grpc.Trailer(&trailer),
 	)
 	if err != nil {
-		return response, errors.New(fmt.Sprintf("Couldn't execute request: %v", err))
+		return response, wrapRpcError(err)
 	}
 
 	err = checkResponseErrorCode(response.GetError())
@@ -729,7 +785,6 @@ func (documentStore *DocumentStore) executeInsertOrReplace(
 		return response, err
 	}
 
-	documentStore.connection.umd.UpdateToken(header, trailer)
 	return response, nil
 }
 
@@ -835,6 +890,38 @@ func (documentStore *DocumentStore) FindByIdByteWithFieldsAndConditionContext(
 	return documentStore.findById(doc, fieldPaths, queryCondition, ctx)
 }
 
+// FindByIdInto method executes gRPC FindById request and decodes the found Document
+// into the struct pointed by out using `ojai` tags.
+// Returns false if the document with given id doesn't exist, out stays unchanged in this case.
+func (documentStore *DocumentStore) FindByIdInto(id *BinaryOrStringId, out interface{}) (bool, error) {
+	return documentStore.FindByIdIntoWithContext(id, out, nil)
+}
+
+// FindByIdIntoWithContext method executes gRPC FindById request and decodes the found Document
+// into the struct pointed by out using `ojai` tags.
+// User defined context is required for this method.
+// Returns false if the document with given id doesn't exist, out stays unchanged in this case.
+func (documentStore *DocumentStore) FindByIdIntoWithContext(
+	id *BinaryOrStringId,
+	out interface{},
+	ctx context.Context,
+) (bool, error) {
+	var doc *Document
+	var err error
+	if id.IsBinary {
+		doc, err = documentStore.FindByIdByteWithContext(id.Binary, ctx)
+	} else {
+		doc, err = documentStore.FindByIdStringWithContext(id.Str, ctx)
+	}
+	if err != nil {
+		return false, err
+	}
+	if !doc.HasId() {
+		return false, nil
+	}
+	return true, doc.Decode(out)
+}
+
 // Method executes gRPC FindById request on server and returns Document if it exists in MapR-DB
 func (documentStore *DocumentStore) findById(
 	doc *Document,
@@ -848,11 +935,9 @@ func (documentStore *DocumentStore) findById(
 	} else {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(context.Background(),
-			time.Duration(documentStore.connection.opts.CallTimeoutSeconds)*time.Second)
+			documentStore.connection.callTimeout)
 		defer cancel()
 	}
-	header := make(metadata.MD)
-	trailer := make(metadata.MD)
 	jsonString, err := json.Marshal(doc)
 	if err != nil {
 		return nil, err
@@ -876,11 +961,9 @@ func (documentStore *DocumentStore) findById(
 
 	response, err := documentStore.connection.stub.FindById(ctx,
 		request,
-		grpc.Header(&header),
-		grpc.Trailer(&trailer),
 	)
 	if err != nil {
-		return nil, err
+		return nil, wrapRpcError(err)
 	}
 
 	deserializedDoc, err := MakeDocument()
@@ -888,7 +971,6 @@ func (documentStore *DocumentStore) findById(
 		return nil, err
 	}
 
-	documentStore.connection.umd.UpdateToken(header, trailer)
 	res, err := checkIsDocumentExists(response.GetError())
 
 	if err != nil {
@@ -907,17 +989,13 @@ func (documentStore *DocumentStore) findById(
 // Method checks FindByID response error code and return true
 // if error code is 0 (NO_ERROR), false if error code 2(DOCUMENT_NOT_FOUND) otherwise error.
 func checkIsDocumentExists(rpcError *RpcError) (bool, error) {
-	switch rpcError.ErrCode {
+	switch rpcError.GetErrCode() {
 	case ErrorCode_NO_ERROR:
 		return true, nil
 	case ErrorCode_DOCUMENT_NOT_FOUND:
 		return false, nil
 	default:
-		return false, errors.New(fmt.Sprintf("unexpected error code recieved from server\n error: %v\n"+
-			" error message : %v\n java stacktrace: %v\n",
-			rpcError.ErrCode.String(),
-			rpcError.ErrorMessage,
-			rpcError.JavaStackTrace))
+		return false, newOjaiError(rpcError)
 	}
 }
 
@@ -1013,17 +1091,28 @@ func (documentStore *DocumentStore) executeDelete(
 	conditionString string,
 	userDefinedContext context.Context,
 ) (bool, error) {
+	response, err := documentStore.sendDelete(docString, conditionString, userDefinedContext)
+	if err != nil {
+		return false, err
+	}
+	return checkIsDocumentExists(response.GetError())
+}
+
+// Method sends gRPC Delete request on server and returns response with unchecked error code.
+func (documentStore *DocumentStore) sendDelete(
+	docString,
+	conditionString string,
+	userDefinedContext context.Context,
+) (*DeleteResponse, error) {
 	var ctx context.Context
 	if userDefinedContext != nil {
 		ctx = userDefinedContext
 	} else {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(context.Background(),
-			time.Duration(documentStore.connection.opts.CallTimeoutSeconds)*time.Second)
+			documentStore.connection.callTimeout)
 		defer cancel()
 	}
-	header := make(metadata.MD)
-	trailer := make(metadata.MD)
 	request := &DeleteRequest{
 		TablePath:       documentStore.storeName,
 		PayloadEncoding: PayloadEncoding_JSON_ENCODING,
@@ -1034,14 +1123,11 @@ func (documentStore *DocumentStore) executeDelete(
 	}
 	response, err := documentStore.connection.stub.Delete(ctx,
 		request,
-		grpc.Header(&header),
-		grpc.Trailer(&trailer),
 	)
 	if err != nil {
-		return false, err
+		return nil, wrapRpcError(err)
 	}
-	documentStore.connection.umd.UpdateToken(header, trailer)
-	return checkIsDocumentExists(response.GetError())
+	return response, nil
 }
 
 // FindAll method executes gRPC Find request and returns all content of specific DocumentStore
@@ -1129,6 +1215,8 @@ func (documentStore *DocumentStore) FindQueryWithContext(
 }
 
 // find executes gRPC Find request, process response and returns QueryResult and error
+// QueryResult streams documents from server and must be closed if it is not read till the end,
+// unless FindOptions.Buffered is set.
 func (documentStore *DocumentStore) find(
 	queryContent *map[string]interface{},
 	findOptions *FindOptions,
@@ -1138,17 +1226,14 @@ func (documentStore *DocumentStore) find(
 	if err != nil {
 		return nil, err
 	}
-	var ctx context.Context
-	if userDefinedContext != nil {
-		ctx = userDefinedContext
-	} else {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(),
-			time.Duration(documentStore.connection.opts.CallTimeoutSeconds)*time.Second)
-		defer cancel()
+	// The context must stay alive while the result is streamed, so it is released by QueryResult.Close.
+	// Call timeout of the connection limits every response instead of the whole stream if context isn't set.
+	var recvTimeout time.Duration
+	if userDefinedContext == nil {
+		userDefinedContext = context.Background()
+		recvTimeout = documentStore.connection.callTimeout
 	}
-	header := make(metadata.MD)
-	trailer := make(metadata.MD)
+	ctx, cancel := context.WithCancel(userDefinedContext)
 	request := &FindRequest{
 		TablePath:        documentStore.storeName,
 		PayloadEncoding:  PayloadEncoding_JSON_ENCODING,
@@ -1156,14 +1241,12 @@ func (documentStore *DocumentStore) find(
 		Data:             &FindRequest_JsonQuery{JsonQuery: string(ser)},
 	}
 	responseStream, err := documentStore.connection.stub.Find(ctx,
-		request,
-		grpc.Header(&header),
-		grpc.Trailer(&trailer))
+		request)
 	if err != nil {
-		return nil, err
+		cancel()
+		return nil, wrapRpcError(err)
 	}
-	documentStore.connection.umd.UpdateToken(header, trailer)
-	return MakeQueryResult(responseStream, findOptions)
+	return makeQueryResult(responseStream, findOptions, cancel, recvTimeout)
 }
 
 // structure that is used in gRPC requests instead of string or []byte _id representation
@@ -1592,19 +1675,33 @@ func (documentStore *DocumentStore) update(
 	documentMutation *MapOrStructMutation,
 	userDefinedContext context.Context,
 ) (bool, error) {
-	docString, err := getDocumentString(id)
+	response, err := documentStore.sendUpdate(id, queryCondition, documentMutation, userDefinedContext)
 	if err != nil {
 		return false, err
 	}
+	return checkIsDocumentExists(response.GetError())
+}
+
+// Method sends gRPC Update request on server and returns response with unchecked error code.
+func (documentStore *DocumentStore) sendUpdate(
+	id *BinaryOrStringId,
+	queryCondition *MapOrStructCondition,
+	documentMutation *MapOrStructMutation,
+	userDefinedContext context.Context,
+) (*UpdateResponse, error) {
+	docString, err := getDocumentString(id)
+	if err != nil {
+		return nil, err
+	}
 	mutationString, err := getMutationString(documentMutation)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	var conditionString string
 	if queryCondition != nil {
 		conditionString, err = getConditionString(queryCondition)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 	}
 	var ctx context.Context
@@ -1613,11 +1710,9 @@ func (documentStore *DocumentStore) update(
 	} else {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(context.Background(),
-			time.Duration(documentStore.connection.opts.CallTimeoutSeconds)*time.Second)
+			documentStore.connection.callTimeout)
 		defer cancel()
 	}
-	header := make(metadata.MD)
-	trailer := make(metadata.MD)
 	request := &UpdateRequest{
 		TablePath:       documentStore.storeName,
 		PayloadEncoding: PayloadEncoding_JSON_ENCODING,
@@ -1629,12 +1724,9 @@ func (documentStore *DocumentStore) update(
 	}
 	response, err := documentStore.connection.stub.Update(ctx,
 		request,
-		grpc.Header(&header),
-		grpc.Trailer(&trailer),
 	)
 	if err != nil {
-		return false, err
+		return nil, wrapRpcError(err)
 	}
-	documentStore.connection.umd.UpdateToken(header, trailer)
-	return checkIsDocumentExists(response.GetError())
+	return response, nil
 }
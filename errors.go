@@ -0,0 +1,139 @@
+package private_maprdb_go_client
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OjaiError is an error returned by MapR Data Access Gateway or gRPC transport.
+// Code is the server error code, Message and JavaStackTrace are the details reported by server.
+// Use errors.Is with sentinel errors to check the kind of error, e.g. errors.Is(err, ErrDocumentExists).
+type OjaiError struct {
+	Code           ErrorCode
+	Message        string
+	JavaStackTrace string
+	cause          error
+}
+
+// Sentinel errors which match any OjaiError with the same Code by errors.Is
+var (
+	ErrTableNotFound          = &OjaiError{Code: ErrorCode_TABLE_NOT_FOUND, Message: "table not found"}
+	ErrIO                     = &OjaiError{Code: ErrorCode_IO_ERROR, Message: "i/o error"}
+	ErrOutOfMemory            = &OjaiError{Code: ErrorCode_OUT_OF_MEMORY, Message: "out of memory"}
+	ErrAccessDenied           = &OjaiError{Code: ErrorCode_ACCESS_DENIED, Message: "access denied"}
+	ErrTableExists            = &OjaiError{Code: ErrorCode_TABLE_ALREADY_EXISTS, Message: "table already exists"}
+	ErrInvalidArgument        = &OjaiError{Code: ErrorCode_INVALID_ARGUMENT, Message: "invalid argument"}
+	ErrUnsupportedOperation   = &OjaiError{Code: ErrorCode_UNSUPPORTED_OPERATION, Message: "unsupported operation"}
+	ErrUnknown                = &OjaiError{Code: ErrorCode_UNKNOWN_ERROR, Message: "unknown error"}
+	ErrUnknownPayloadEncoding = &OjaiError{Code: ErrorCode_UNKNOWN_PAYLOAD_ENCODING, Message: "unknown payload encoding"}
+	ErrClusterNotFound        = &OjaiError{Code: ErrorCode_CLUSTER_NOT_FOUND, Message: "cluster not found"}
+	ErrPathNotFound           = &OjaiError{Code: ErrorCode_PATH_NOT_FOUND, Message: "path not found"}
+	ErrDocumentExists         = &OjaiError{Code: ErrorCode_DOCUMENT_ALREADY_EXISTS, Message: "document already exists"}
+	ErrDocumentNotFound       = &OjaiError{Code: ErrorCode_DOCUMENT_NOT_FOUND, Message: "document not found"}
+	ErrEncoding               = &OjaiError{Code: ErrorCode_ENCODING_ERROR, Message: "encoding error"}
+	ErrDecoding               = &OjaiError{Code: ErrorCode_DECODING_ERROR, Message: "decoding error"}
+	ErrIllegalMutation        = &OjaiError{Code: ErrorCode_ILLEGAL_MUTATION, Message: "illegal mutation"}
+)
+
+// ErrorCode of gRPC status codes which are returned by transport instead of RpcError
+var grpcErrorCodes = map[codes.Code]ErrorCode{
+	codes.Canceled:          ErrorCode_IO_ERROR,
+	codes.DeadlineExceeded:  ErrorCode_IO_ERROR,
+	codes.Unavailable:       ErrorCode_IO_ERROR,
+	codes.Aborted:           ErrorCode_IO_ERROR,
+	codes.InvalidArgument:   ErrorCode_INVALID_ARGUMENT,
+	codes.Unauthenticated:   ErrorCode_ACCESS_DENIED,
+	codes.PermissionDenied:  ErrorCode_ACCESS_DENIED,
+	codes.Unimplemented:     ErrorCode_UNSUPPORTED_OPERATION,
+	codes.ResourceExhausted: ErrorCode_OUT_OF_MEMORY,
+}
+
+// Error interface implementation, Java stack trace is not included into the message
+func (ojaiError *OjaiError) Error() string {
+	if len(ojaiError.Message) == 0 {
+		return ojaiError.Code.String()
+	}
+	return fmt.Sprintf("%v: %v", ojaiError.Code.String(), ojaiError.Message)
+}
+
+// Is reports whether target is OjaiError with the same Code, so sentinel errors can be used with errors.Is
+func (ojaiError *OjaiError) Is(target error) bool {
+	var targetError *OjaiError
+	if !errors.As(target, &targetError) {
+		return false
+	}
+	return targetError.Code == ojaiError.Code
+}
+
+// Unwrap returns gRPC status error wrapped by OjaiError or nil if error was reported by server in RpcError
+func (ojaiError *OjaiError) Unwrap() error {
+	return ojaiError.cause
+}
+
+// GRPCStatus returns status of the wrapped gRPC error, so status.Code and status.FromError work with OjaiError
+func (ojaiError *OjaiError) GRPCStatus() *status.Status {
+	if ojaiError.cause == nil {
+		return status.New(codes.Unknown, ojaiError.Error())
+	}
+	return status.Convert(ojaiError.cause)
+}
+
+// newOjaiError converts RpcError of the response into OjaiError or returns nil if there is no error
+func newOjaiError(rpcError *RpcError) error {
+	if rpcError.GetErrCode() == ErrorCode_NO_ERROR {
+		return nil
+	}
+	return &OjaiError{
+		Code:           rpcError.GetErrCode(),
+		Message:        rpcError.GetErrorMessage(),
+		JavaStackTrace: rpcError.GetJavaStackTrace(),
+	}
+}
+
+// wrapRpcError converts error of gRPC call into OjaiError, errors which are not gRPC statuses are returned as is
+func wrapRpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var ojaiError *OjaiError
+	if errors.As(err, &ojaiError) {
+		return err
+	}
+	grpcStatus, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	code, ok := grpcErrorCodes[grpcStatus.Code()]
+	if !ok {
+		code = ErrorCode_UNKNOWN_ERROR
+	}
+	return &OjaiError{Code: code, Message: grpcStatus.Message(), cause: err}
+}
+
+// CallError returns OjaiError of failed gRPC call or error reported by server in the reply, nil if the call succeeded,
+// e.g. for interceptors which record result of calls. Reply of stream call is the received message.
+func CallError(err error, reply interface{}) error {
+	if err != nil {
+		return wrapRpcError(err)
+	}
+	if response, ok := reply.(interface{ GetError() *RpcError }); ok {
+		return newOjaiError(response.GetError())
+	}
+	return nil
+}
+
+// ErrorCodeOf returns ErrorCode of OjaiError, NO_ERROR for nil and UNKNOWN_ERROR for other errors,
+// e.g. for interceptors which record result of calls
+func ErrorCodeOf(err error) ErrorCode {
+	var ojaiError *OjaiError
+	if err == nil {
+		return ErrorCode_NO_ERROR
+	}
+	if errors.As(err, &ojaiError) {
+		return ojaiError.Code
+	}
+	return ErrorCode_UNKNOWN_ERROR
+}
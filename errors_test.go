@@ -0,0 +1,66 @@
+package private_maprdb_go_client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOjaiError(t *testing.T) {
+	err := newOjaiError(&RpcError{
+		ErrCode:        ErrorCode_DOCUMENT_ALREADY_EXISTS,
+		ErrorMessage:   "document user1 already exists",
+		JavaStackTrace: "at com.mapr.Table.insert",
+	})
+	assert.Equal(t, "DOCUMENT_ALREADY_EXISTS: document user1 already exists", err.Error())
+	assert.True(t, errors.Is(err, ErrDocumentExists))
+	assert.True(t, errors.Is(fmt.Errorf("insert failed: %w", err), ErrDocumentExists))
+	assert.False(t, errors.Is(err, ErrDocumentNotFound))
+
+	var ojaiError *OjaiError
+	assert.True(t, errors.As(err, &ojaiError))
+	assert.Equal(t, ErrorCode_DOCUMENT_ALREADY_EXISTS, ojaiError.Code)
+	assert.Equal(t, "at com.mapr.Table.insert", ojaiError.JavaStackTrace)
+	assert.Equal(t, codes.Unknown, status.Code(err))
+
+	assert.Nil(t, newOjaiError(&RpcError{ErrCode: ErrorCode_NO_ERROR}))
+	assert.Nil(t, newOjaiError(nil))
+}
+
+func TestWrapRpcError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"unauthenticated", status.Error(codes.Unauthenticated, "token expired"), ErrAccessDenied},
+		{"unavailable", status.Error(codes.Unavailable, "connection refused"), ErrIO},
+		{"deadline", status.Error(codes.DeadlineExceeded, "deadline exceeded"), ErrIO},
+		{"unimplemented", status.Error(codes.Unimplemented, "unknown method"), ErrUnsupportedOperation},
+		{"internal", status.Error(codes.Internal, "internal"), ErrUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := wrapRpcError(tt.err)
+			assert.True(t, errors.Is(err, tt.want))
+			assert.Equal(t, status.Code(tt.err), status.Code(err))
+			assert.Equal(t, tt.err, errors.Unwrap(err))
+		})
+	}
+
+	assert.Nil(t, wrapRpcError(nil))
+	plain := errors.New("plain error")
+	assert.Equal(t, plain, wrapRpcError(plain))
+	assert.Equal(t, ErrTableNotFound, wrapRpcError(ErrTableNotFound))
+}
+
+func TestErrorCodeOf(t *testing.T) {
+	assert.Equal(t, ErrorCode_NO_ERROR, ErrorCodeOf(nil))
+	assert.Equal(t, ErrorCode_TABLE_NOT_FOUND, ErrorCodeOf(fmt.Errorf("find failed: %w", ErrTableNotFound)))
+	assert.Equal(t, ErrorCode_IO_ERROR, ErrorCodeOf(wrapRpcError(status.Error(codes.Unavailable, "refused"))))
+	assert.Equal(t, ErrorCode_UNKNOWN_ERROR, ErrorCodeOf(errors.New("plain error")))
+}
@@ -0,0 +1,225 @@
+package private_maprdb_go_client
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldPath parsed OJAI field path, e.g. a.b[0].`c.d`.
+// Field names are separated by dots and followed by array indexes in brackets, [] selects every element
+// of the array in conditions. Names which contain dots, brackets, backticks or backslashes or are empty
+// must be quoted by backticks, backslash escapes the next character in both quoted and unquoted names.
+// FieldPath is immutable, so it can be shared.
+type FieldPath struct {
+	segments []FieldSegment
+}
+
+// FieldSegment single element of the FieldPath: field name or array index
+type FieldSegment struct {
+	name    string
+	index   int
+	isIndex bool
+}
+
+// MakeFieldPath parses and returns field path, error describes the position of the invalid part of the path.
+// example : "a.`b.c`[1].d" consists of segments a, b.c, [1] and d
+func MakeFieldPath(fieldPath string) (*FieldPath, error) {
+	if len(fieldPath) == 0 {
+		return nil, errors.New("field path can't be empty")
+	}
+	invalid := func(position int, reason string) error {
+		return fmt.Errorf("invalid field path %q: %v at position %v", fieldPath, reason, position)
+	}
+	var segments []FieldSegment
+	expectName := true
+	for i := 0; i < len(fieldPath); {
+		if expectName {
+			var name strings.Builder
+			start := i
+			if fieldPath[i] == '`' {
+				closed := false
+				for i++; i < len(fieldPath) && !closed; i++ {
+					switch c := fieldPath[i]; c {
+					case '`':
+						closed = true
+					case '\\':
+						if i++; i == len(fieldPath) {
+							return nil, invalid(i, "dangling escape")
+						}
+						name.WriteByte(fieldPath[i])
+					default:
+						name.WriteByte(c)
+					}
+				}
+				if !closed {
+					return nil, invalid(start, "unclosed quote")
+				}
+				if i < len(fieldPath) && fieldPath[i] != '.' && fieldPath[i] != '[' {
+					return nil, invalid(i, fmt.Sprintf("unexpected character %q after quoted name", fieldPath[i]))
+				}
+			} else {
+				for ; i < len(fieldPath) && fieldPath[i] != '.' && fieldPath[i] != '['; i++ {
+					switch c := fieldPath[i]; c {
+					case ']', '`':
+						return nil, invalid(i, fmt.Sprintf("unexpected character %q", c))
+					case '\\':
+						if i++; i == len(fieldPath) {
+							return nil, invalid(i, "dangling escape")
+						}
+						name.WriteByte(fieldPath[i])
+					default:
+						name.WriteByte(c)
+					}
+				}
+				if i == start {
+					return nil, invalid(start, "empty field name")
+				}
+			}
+			segments = append(segments, FieldSegment{name: name.String()})
+			expectName = false
+			continue
+		}
+		switch fieldPath[i] {
+		case '.':
+			expectName = true
+			i++
+		case '[':
+			end := strings.IndexByte(fieldPath[i:], ']')
+			if end < 0 {
+				return nil, invalid(i, "unclosed index")
+			}
+			digits := fieldPath[i+1 : i+end]
+			index := -1
+			if len(digits) != 0 {
+				var err error
+				if strings.Trim(digits, "0123456789") != "" {
+					return nil, invalid(i+1, fmt.Sprintf("invalid index %q", digits))
+				}
+				if index, err = strconv.Atoi(digits); err != nil {
+					return nil, invalid(i+1, fmt.Sprintf("invalid index %q", digits))
+				}
+			}
+			segments = append(segments, FieldSegment{index: index, isIndex: true})
+			i += end + 1
+		default:
+			return nil, invalid(i, fmt.Sprintf("unexpected character %q", fieldPath[i]))
+		}
+	}
+	if expectName {
+		return nil, invalid(len(fieldPath), "empty field name")
+	}
+	return &FieldPath{segments: segments}, nil
+}
+
+// MakeFieldPathFromNames creates and returns field path of nested fields with given names,
+// names are used as is, so they may contain any characters
+func MakeFieldPathFromNames(names ...string) *FieldPath {
+	segments := make([]FieldSegment, 0, len(names))
+	for _, name := range names {
+		segments = append(segments, FieldSegment{name: name})
+	}
+	return &FieldPath{segments: segments}
+}
+
+// Child returns new field path of the nested field with given name
+func (fieldPath *FieldPath) Child(name string) *FieldPath {
+	return fieldPath.append(FieldSegment{name: name})
+}
+
+// Index returns new field path of the element of the array with given index,
+// error is returned for negative index. Use AnyIndex for [] segment.
+func (fieldPath *FieldPath) Index(index int) (*FieldPath, error) {
+	if index < 0 {
+		return nil, fmt.Errorf("invalid field path %v[%v]: negative index", fieldPath, index)
+	}
+	return fieldPath.element(index), nil
+}
+
+// AnyIndex returns new field path with [] segment which selects every element of the array in conditions
+func (fieldPath *FieldPath) AnyIndex() *FieldPath {
+	return fieldPath.append(FieldSegment{index: -1, isIndex: true})
+}
+
+// element returns new field path of the element of the array with given non-negative index
+func (fieldPath *FieldPath) element(index int) *FieldPath {
+	return fieldPath.append(FieldSegment{index: index, isIndex: true})
+}
+
+func (fieldPath *FieldPath) append(segment FieldSegment) *FieldPath {
+	segments := make([]FieldSegment, len(fieldPath.segments), len(fieldPath.segments)+1)
+	copy(segments, fieldPath.segments)
+	return &FieldPath{segments: append(segments, segment)}
+}
+
+// Segments returns copy of the segments of the field path
+func (fieldPath *FieldPath) Segments() []FieldSegment {
+	return append([]FieldSegment{}, fieldPath.segments...)
+}
+
+// hasAnyIndex checks whether the field path contains [] segment
+func (fieldPath *FieldPath) hasAnyIndex() bool {
+	for _, segment := range fieldPath.segments {
+		if segment.IsAnyIndex() {
+			return true
+		}
+	}
+	return false
+}
+
+// Stringer interface implementation, the result is parsed by MakeFieldPath into the same field path.
+// Names are quoted only if it's required, so the result of simple paths like a.b[0] is the same as the source
+func (fieldPath *FieldPath) String() string {
+	var builder strings.Builder
+	for index, segment := range fieldPath.segments {
+		if index > 0 && !segment.isIndex {
+			builder.WriteByte('.')
+		}
+		builder.WriteString(segment.String())
+	}
+	return builder.String()
+}
+
+// Name returns name of the field or empty string for array index
+func (segment FieldSegment) Name() string {
+	return segment.name
+}
+
+// IsIndex checks whether the segment is array index, including [] segment
+func (segment FieldSegment) IsIndex() bool {
+	return segment.isIndex
+}
+
+// IsAnyIndex checks whether the segment is [] which selects every element of the array
+func (segment FieldSegment) IsAnyIndex() bool {
+	return segment.isIndex && segment.index < 0
+}
+
+// Index returns array index of the segment or -1 for [] segment
+func (segment FieldSegment) Index() int {
+	return segment.index
+}
+
+// Stringer interface implementation
+func (segment FieldSegment) String() string {
+	switch {
+	case segment.IsAnyIndex():
+		return "[]"
+	case segment.isIndex:
+		return "[" + strconv.Itoa(segment.index) + "]"
+	case len(segment.name) != 0 && !strings.ContainsAny(segment.name, ".[]`\\"):
+		return segment.name
+	default:
+		var builder strings.Builder
+		builder.WriteByte('`')
+		for i := 0; i < len(segment.name); i++ {
+			if c := segment.name[i]; c == '`' || c == '\\' {
+				builder.WriteByte('\\')
+			}
+			builder.WriteByte(segment.name[i])
+		}
+		builder.WriteByte('`')
+		return builder.String()
+	}
+}
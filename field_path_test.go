@@ -0,0 +1,85 @@
+package private_maprdb_go_client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeFieldPath(t *testing.T) {
+	tests := []struct {
+		fieldPath string
+		want      []FieldSegment
+		canonical string
+	}{
+		{"a", []FieldSegment{{name: "a"}}, "a"},
+		{"a.b[0].c", []FieldSegment{{name: "a"}, {name: "b"}, {index: 0, isIndex: true}, {name: "c"}}, "a.b[0].c"},
+		{"tags[]", []FieldSegment{{name: "tags"}, {index: -1, isIndex: true}}, "tags[]"},
+		{"m[1][2]", []FieldSegment{{name: "m"}, {index: 1, isIndex: true}, {index: 2, isIndex: true}}, "m[1][2]"},
+		{"first-name.$price.with space", []FieldSegment{{name: "first-name"}, {name: "$price"}, {name: "with space"}},
+			"first-name.$price.with space"},
+		{"город.名前", []FieldSegment{{name: "город"}, {name: "名前"}}, "город.名前"},
+		{"a.`b.c`[1]", []FieldSegment{{name: "a"}, {name: "b.c"}, {index: 1, isIndex: true}}, "a.`b.c`[1]"},
+		{"`a`.`b`", []FieldSegment{{name: "a"}, {name: "b"}}, "a.b"},
+		{"`back\\`tick`", []FieldSegment{{name: "back`tick"}}, "`back\\`tick`"},
+		{"a\\.b", []FieldSegment{{name: "a.b"}}, "`a.b`"},
+		{"`x\\\\y`", []FieldSegment{{name: "x\\y"}}, "`x\\\\y`"},
+		{"``.a", []FieldSegment{{name: ""}, {name: "a"}}, "``.a"},
+		{"'quoted'", []FieldSegment{{name: "'quoted'"}}, "'quoted'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fieldPath, func(t *testing.T) {
+			path, err := MakeFieldPath(tt.fieldPath)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, path.Segments())
+			assert.Equal(t, tt.canonical, path.String())
+			parsed, err := MakeFieldPath(path.String())
+			assert.Nil(t, err)
+			assert.Equal(t, path, parsed)
+		})
+	}
+}
+
+func TestMakeFieldPath_Invalid(t *testing.T) {
+	tests := []struct {
+		fieldPath string
+		want      string
+	}{
+		{"", "field path can't be empty"},
+		{"a..b", `invalid field path "a..b": empty field name at position 2`},
+		{".a", `invalid field path ".a": empty field name at position 0`},
+		{"a.", `invalid field path "a.": empty field name at position 2`},
+		{"[0]", `invalid field path "[0]": empty field name at position 0`},
+		{"a.`b", "invalid field path \"a.`b\": unclosed quote at position 2"},
+		{"`a`b", "invalid field path \"`a`b\": unexpected character 'b' after quoted name at position 3"},
+		{"a[1", `invalid field path "a[1": unclosed index at position 1`},
+		{"a[-1]", `invalid field path "a[-1]": invalid index "-1" at position 2`},
+		{"a[x]", `invalid field path "a[x]": invalid index "x" at position 2`},
+		{"a[0]b", `invalid field path "a[0]b": unexpected character 'b' at position 4`},
+		{"a]", `invalid field path "a]": unexpected character ']' at position 1`},
+		{"a\\", `invalid field path "a\\": dangling escape at position 2`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fieldPath, func(t *testing.T) {
+			_, err := MakeFieldPath(tt.fieldPath)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
+
+func TestFieldPath_Build(t *testing.T) {
+	element, err := MakeFieldPathFromNames("a", "b.c").Index(2)
+	assert.Nil(t, err)
+	path := element.Child("d`e")
+	assert.Equal(t, "a.`b.c`[2].`d\\`e`", path.String())
+	parsed, err := MakeFieldPath(path.String())
+	assert.Nil(t, err)
+	assert.Equal(t, path, parsed)
+	assert.Equal(t, "a", MakeFieldPathFromNames("a").String())
+
+	anyIndex := MakeFieldPathFromNames("tags").AnyIndex()
+	assert.Equal(t, "tags[]", anyIndex.String())
+	assert.True(t, anyIndex.Segments()[1].IsAnyIndex())
+	_, err = MakeFieldPathFromNames("tags").Index(-1)
+	assert.EqualError(t, err, "invalid field path tags[-1]: negative index")
+}
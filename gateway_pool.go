@@ -0,0 +1,309 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LoadBalancingPolicy selects gateway for each call if connection string contains several hosts
+type LoadBalancingPolicy int
+
+const (
+	// RoundRobin sends calls to healthy gateways in turn
+	RoundRobin LoadBalancingPolicy = iota
+	// LeastLoaded sends call to healthy gateway with the least number of active calls and streams
+	LeastLoaded
+)
+
+var loadBalancingPolicies = [...]string{
+	"roundRobin",
+	"leastLoaded",
+}
+
+// String method returns name of the policy used in loadBalancing parameter of connection string
+func (policy LoadBalancingPolicy) String() string {
+	if policy < 0 || int(policy) >= len(loadBalancingPolicies) {
+		return "unknown"
+	}
+	return loadBalancingPolicies[policy]
+}
+
+// parseLoadBalancingPolicy returns policy by its name
+func parseLoadBalancingPolicy(name string) (LoadBalancingPolicy, error) {
+	for i, policy := range loadBalancingPolicies {
+		if policy == name {
+			return LoadBalancingPolicy(i), nil
+		}
+	}
+	return RoundRobin, fmt.Errorf("invalid value '%v' of connection string parameter 'loadBalancing', "+
+		"expected one of roundRobin, leastLoaded", name)
+}
+
+// gateway channel of single Data Access Gateway.
+// active number of active calls and streams, healthy is cleared when gateway is ejected.
+// failures and reconnecting are used only by health monitor goroutine.
+type gateway struct {
+	target       string
+	channel      *redialingChannel
+	active       int64
+	healthy      int32
+	failures     int
+	reconnecting bool
+}
+
+// isHealthy method checks whether gateway wasn't ejected
+func (gateway *gateway) isHealthy() bool {
+	return atomic.LoadInt32(&gateway.healthy) != 0
+}
+
+// setHealthy method ejects gateway or returns it to the pool
+func (gateway *gateway) setHealthy(healthy bool) {
+	var value int32
+	if healthy {
+		value = 1
+	}
+	atomic.StoreInt32(&gateway.healthy, value)
+}
+
+// Invoke method performs unary call on the gateway and counts it as active
+func (gateway *gateway) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	atomic.AddInt64(&gateway.active, 1)
+	defer atomic.AddInt64(&gateway.active, -1)
+	return gateway.channel.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream method opens stream on the gateway which is counted as active until it's finished
+func (gateway *gateway) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	atomic.AddInt64(&gateway.active, 1)
+	clientStream, err := gateway.channel.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		atomic.AddInt64(&gateway.active, -1)
+		return nil, err
+	}
+	countedStream := &countedClientStream{ClientStream: clientStream, gateway: gateway, done: make(chan struct{})}
+	// Stream which is read to the end or failed is released by RecvMsg,
+	// canceled stream is released here unless context can't be canceled
+	if ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				countedStream.release()
+			case <-countedStream.done:
+			}
+		}()
+	}
+	return countedStream, nil
+}
+
+// countedClientStream decrements number of active calls of gateway when stream is finished
+type countedClientStream struct {
+	grpc.ClientStream
+	gateway *gateway
+	once    sync.Once
+	done    chan struct{}
+}
+
+// RecvMsg method releases the stream when it's read to the end or failed
+func (countedStream *countedClientStream) RecvMsg(m interface{}) error {
+	err := countedStream.ClientStream.RecvMsg(m)
+	if err != nil {
+		countedStream.release()
+	}
+	return err
+}
+
+// release method decrements number of active calls once
+func (countedStream *countedClientStream) release() {
+	countedStream.once.Do(func() {
+		atomic.AddInt64(&countedStream.gateway.active, -1)
+		close(countedStream.done)
+	})
+}
+
+// gatewayPool balances calls between gateways and fails over to another gateway
+// if idempotent call failed with Unavailable code
+type gatewayPool struct {
+	gateways           []*gateway
+	policy             LoadBalancingPolicy
+	retryNonIdempotent bool
+	logger             Logger
+	next               uint64
+}
+
+// makeGatewayPool creates pool with channel for each target, channels connect lazily
+func makeGatewayPool(
+	targets []string,
+	dial func(target string) (*grpc.ClientConn, error),
+	policy LoadBalancingPolicy,
+	retryNonIdempotent bool,
+	logger Logger,
+) (*gatewayPool, error) {
+	pool := &gatewayPool{policy: policy, retryNonIdempotent: retryNonIdempotent, logger: logger}
+	for _, target := range targets {
+		target := target
+		channel := &redialingChannel{dial: func() (*grpc.ClientConn, error) {
+			return dial(target)
+		}}
+		if err := channel.redial(); err != nil {
+			pool.Close()
+			return nil, err
+		}
+		pool.gateways = append(pool.gateways, &gateway{target: target, channel: channel, healthy: 1})
+	}
+	return pool, nil
+}
+
+// pick method returns gateway for the next call, ejected and excluded gateways are used
+// only if there are no other ones
+func (pool *gatewayPool) pick(excluded []*gateway) *gateway {
+	var candidates, fallback []*gateway
+	for _, gateway := range pool.gateways {
+		if containsGateway(excluded, gateway) {
+			continue
+		}
+		if gateway.isHealthy() {
+			candidates = append(candidates, gateway)
+		} else {
+			fallback = append(fallback, gateway)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = fallback
+	}
+	if len(candidates) == 0 {
+		candidates = pool.gateways
+	}
+	offset := int(atomic.AddUint64(&pool.next, 1) % uint64(len(candidates)))
+	if pool.policy == RoundRobin {
+		return candidates[offset]
+	}
+	// ties are broken in round-robin order, so idle gateways share the load
+	picked := candidates[offset]
+	for i := 1; i < len(candidates); i++ {
+		candidate := candidates[(offset+i)%len(candidates)]
+		if atomic.LoadInt64(&candidate.active) < atomic.LoadInt64(&picked.active) {
+			picked = candidate
+		}
+	}
+	return picked
+}
+
+// containsGateway checks whether gateway is in the slice
+func containsGateway(gateways []*gateway, gateway *gateway) bool {
+	for _, g := range gateways {
+		if g == gateway {
+			return true
+		}
+	}
+	return false
+}
+
+// failover method ejects gateway which is unavailable and checks whether call can be sent to another gateway
+func (pool *gatewayPool) failover(ctx context.Context, gateway *gateway, req interface{}, err error) bool {
+	if len(pool.gateways) == 1 || status.Code(err) != codes.Unavailable || ctx.Err() != nil {
+		return false
+	}
+	if gateway.isHealthy() {
+		pool.logger.Log(ctx, slog.LevelWarn, "gateway ejected", "target", gateway.target, "error", err)
+		gateway.setHealthy(false)
+	}
+	return req == nil || pool.retryNonIdempotent || isIdempotent(req)
+}
+
+// Invoke method performs unary call on gateway selected by policy
+func (pool *gatewayPool) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	var excluded []*gateway
+	for {
+		gateway := pool.pick(excluded)
+		err := gateway.Invoke(ctx, method, args, reply, opts...)
+		excluded = append(excluded, gateway)
+		if err == nil || !pool.failover(ctx, gateway, args, err) || len(excluded) == len(pool.gateways) {
+			return err
+		}
+	}
+}
+
+// NewStream method opens stream on gateway selected by policy
+func (pool *gatewayPool) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	var excluded []*gateway
+	for {
+		gateway := pool.pick(excluded)
+		clientStream, err := gateway.NewStream(ctx, desc, method, opts...)
+		excluded = append(excluded, gateway)
+		if err == nil || !pool.failover(ctx, gateway, nil, err) || len(excluded) == len(pool.gateways) {
+			return clientStream, err
+		}
+	}
+}
+
+// state method returns state of the connection according to health of gateways
+func (pool *gatewayPool) state() ConnectionState {
+	reconnecting := false
+	for _, gateway := range pool.gateways {
+		if gateway.isHealthy() {
+			return ConnectionStateReady
+		}
+		reconnecting = reconnecting || gateway.reconnecting
+	}
+	if reconnecting {
+		return ConnectionStateConnecting
+	}
+	return ConnectionStateUnhealthy
+}
+
+// Close method closes channels of all gateways
+func (pool *gatewayPool) Close() error {
+	var err error
+	for _, gateway := range pool.gateways {
+		if closeErr := gateway.channel.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// Pin method returns connection which sends all calls to the same gateway,
+// e.g. to read documents written by previous calls from the same gateway.
+// Pinned connection shares channels with the parent one, its Close doesn't close them.
+func (connection *Connection) Pin() *Connection {
+	gateway := connection.pool.pick(nil)
+	return &Connection{
+		stub:        NewMapRDbServerClient(gateway),
+		auth:        connection.auth,
+		pool:        connection.pool,
+		callTimeout: connection.callTimeout,
+		logger:      connection.logger,
+		health:      connection.health,
+		done:        connection.done,
+		pinned:      true,
+	}
+}
@@ -0,0 +1,84 @@
+package private_maprdb_go_client
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// makeTestPool returns pool of gateways without channels
+func makeTestPool(policy LoadBalancingPolicy, targets ...string) *gatewayPool {
+	pool := &gatewayPool{policy: policy, logger: discardLogger{}}
+	for _, target := range targets {
+		pool.gateways = append(pool.gateways, &gateway{target: target, healthy: 1})
+	}
+	return pool
+}
+
+// pickTargets returns targets of gateways picked count times
+func pickTargets(pool *gatewayPool, count int, excluded ...*gateway) []string {
+	var targets []string
+	for i := 0; i < count; i++ {
+		targets = append(targets, pool.pick(excluded).target)
+	}
+	return targets
+}
+
+func TestGatewayPool_Pick(t *testing.T) {
+	pool := makeTestPool(RoundRobin, "a", "b", "c")
+	assert.Equal(t, []string{"b", "c", "a", "b"}, pickTargets(pool, 4))
+	pool.gateways[1].setHealthy(false)
+	assert.Equal(t, []string{"c", "a", "c", "a"}, pickTargets(pool, 4))
+	assert.Equal(t, []string{"a", "a"}, pickTargets(pool, 2, pool.gateways[2]))
+	for _, gateway := range pool.gateways {
+		gateway.setHealthy(false)
+	}
+	assert.Equal(t, []string{"c", "a", "b"}, pickTargets(pool, 3))
+	assert.Equal(t, ConnectionStateUnhealthy, pool.state())
+	pool.gateways[0].reconnecting = true
+	assert.Equal(t, ConnectionStateConnecting, pool.state())
+
+	pool = makeTestPool(LeastLoaded, "a", "b", "c")
+	pool.gateways[0].active = 3
+	pool.gateways[1].active = 1
+	pool.gateways[2].active = 1
+	assert.Equal(t, []string{"b", "c", "b"}, pickTargets(pool, 3))
+	pool.gateways[1].setHealthy(false)
+	assert.Equal(t, []string{"c", "c"}, pickTargets(pool, 2))
+	assert.Equal(t, ConnectionStateReady, pool.state())
+}
+
+func TestParseLoadBalancingPolicy(t *testing.T) {
+	for _, policy := range []LoadBalancingPolicy{RoundRobin, LeastLoaded} {
+		parsed, err := parseLoadBalancingPolicy(policy.String())
+		assert.Nil(t, err)
+		assert.Equal(t, policy, parsed)
+	}
+	_, err := parseLoadBalancingPolicy("random")
+	assert.NotNil(t, err)
+	assert.Equal(t, "unknown", LoadBalancingPolicy(5).String())
+}
+
+// eofClientStream returns io.EOF from every RecvMsg
+type eofClientStream struct {
+	grpc.ClientStream
+}
+
+func (stream *eofClientStream) RecvMsg(m interface{}) error {
+	return io.EOF
+}
+
+func TestCountedClientStream(t *testing.T) {
+	gateway := &gateway{target: "a", healthy: 1, active: 1}
+	countedStream := &countedClientStream{ClientStream: &eofClientStream{}, gateway: gateway, done: make(chan struct{})}
+	assert.Equal(t, io.EOF, countedStream.RecvMsg(&FindResponse{}))
+	assert.Equal(t, io.EOF, countedStream.RecvMsg(&FindResponse{}))
+	assert.Equal(t, int64(0), gateway.active)
+	select {
+	case <-countedStream.done:
+	default:
+		t.Error("stream isn't released")
+	}
+}
@@ -0,0 +1,243 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// ConnectionState state of Connection reported by State
+type ConnectionState int
+
+const (
+	ConnectionStateConnecting ConnectionState = iota
+	ConnectionStateReady
+	ConnectionStateUnhealthy
+	ConnectionStateClosed
+)
+
+var connectionStates = [...]string{
+	"CONNECTING",
+	"READY",
+	"UNHEALTHY",
+	"CLOSED",
+}
+
+// String method returns name of the state
+func (state ConnectionState) String() string {
+	if state < 0 || int(state) >= len(connectionStates) {
+		return "UNKNOWN"
+	}
+	return connectionStates[state]
+}
+
+// HealthCheckOptions parameters of background health monitor which pings every gateway,
+// monitor is started with default parameters if connection string contains several hosts
+// Interval delay between pings, 30 seconds by default
+// Timeout timeout of each ping, call timeout by default
+// FailureThreshold consecutive failed pings after which connection becomes UNHEALTHY, 1 by default
+// ReconnectThreshold consecutive failed pings after which gRPC channel is dialed again, reconnect is disabled if zero
+// OnStateChange is called from monitor goroutine when state of the connection changes
+type HealthCheckOptions struct {
+	Interval           time.Duration
+	Timeout            time.Duration
+	FailureThreshold   int
+	ReconnectThreshold int
+	OnStateChange      func(previous, current ConnectionState)
+}
+
+// ErrConnectionClosed is returned by WaitForReady if connection is closed
+var ErrConnectionClosed = errors.New("connection is closed")
+
+// connectionHealth state of the connection guarded by mutex.
+// changed is closed and replaced when state changes, so waiters are woken up.
+type connectionHealth struct {
+	mutex         sync.Mutex
+	state         ConnectionState
+	changed       chan struct{}
+	onStateChange func(previous, current ConnectionState)
+	logger        Logger
+}
+
+// makeConnectionHealth returns health in CONNECTING state
+func makeConnectionHealth(onStateChange func(previous, current ConnectionState), logger Logger) *connectionHealth {
+	return &connectionHealth{
+		state:         ConnectionStateConnecting,
+		changed:       make(chan struct{}),
+		onStateChange: onStateChange,
+		logger:        logger,
+	}
+}
+
+// current method returns state and channel which is closed when state changes
+func (health *connectionHealth) current() (ConnectionState, chan struct{}) {
+	health.mutex.Lock()
+	defer health.mutex.Unlock()
+	return health.state, health.changed
+}
+
+// setState method changes state and notifies waiters and callback, CLOSED state is final
+func (health *connectionHealth) setState(state ConnectionState) {
+	health.mutex.Lock()
+	previous := health.state
+	if previous == state || previous == ConnectionStateClosed {
+		health.mutex.Unlock()
+		return
+	}
+	health.state = state
+	close(health.changed)
+	health.changed = make(chan struct{})
+	health.mutex.Unlock()
+	health.logger.Log(context.Background(), slog.LevelInfo, "connection state changed",
+		"previous", previous.String(), "current", state.String())
+	if health.onStateChange != nil {
+		health.onStateChange(previous, state)
+	}
+}
+
+// redialingChannel gRPC channel which can be replaced by newly dialed one,
+// so stub keeps working after reconnect
+type redialingChannel struct {
+	dial    func() (*grpc.ClientConn, error)
+	mutex   sync.RWMutex
+	current *grpc.ClientConn
+	closed  bool
+}
+
+// Invoke method performs unary call on the current channel
+func (channel *redialingChannel) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	return channel.get().Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream method opens stream on the current channel
+func (channel *redialingChannel) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return channel.get().NewStream(ctx, desc, method, opts...)
+}
+
+// get method returns the current channel
+func (channel *redialingChannel) get() *grpc.ClientConn {
+	channel.mutex.RLock()
+	defer channel.mutex.RUnlock()
+	return channel.current
+}
+
+// redial method dials new channel and closes the previous one
+func (channel *redialingChannel) redial() error {
+	conn, err := channel.dial()
+	if err != nil {
+		return err
+	}
+	channel.mutex.Lock()
+	if channel.closed {
+		channel.mutex.Unlock()
+		conn.Close()
+		return ErrConnectionClosed
+	}
+	previous := channel.current
+	channel.current = conn
+	channel.mutex.Unlock()
+	if previous != nil {
+		return previous.Close()
+	}
+	return nil
+}
+
+// Close method closes the current channel, channel can't be dialed again after Close
+func (channel *redialingChannel) Close() error {
+	channel.mutex.Lock()
+	defer channel.mutex.Unlock()
+	channel.closed = true
+	return channel.current.Close()
+}
+
+// State method returns state of the connection. Without health check the connection is READY
+// after it's created and CLOSED after Close.
+func (connection *Connection) State() ConnectionState {
+	state, _ := connection.health.current()
+	return state
+}
+
+// WaitForReady method blocks until the connection is READY, ctx is done or connection is closed
+func (connection *Connection) WaitForReady(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for {
+		state, changed := connection.health.current()
+		switch state {
+		case ConnectionStateReady:
+			return nil
+		case ConnectionStateClosed:
+			return ErrConnectionClosed
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// monitorHealth method pings every gateway until connection is closed, ejects gateways which failed
+// FailureThreshold consecutive pings and dials them again after ReconnectThreshold consecutive failures.
+// Connection is READY while at least one gateway is healthy.
+func (connection *Connection) monitorHealth(options HealthCheckOptions) {
+	ticker := time.NewTicker(options.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-connection.done:
+			return
+		case <-ticker.C:
+		}
+		for _, gateway := range connection.pool.gateways {
+			connection.checkGateway(gateway, options)
+		}
+		connection.health.setState(connection.pool.state())
+	}
+}
+
+// checkGateway method pings gateway and updates its health
+func (connection *Connection) checkGateway(gateway *gateway, options HealthCheckOptions) {
+	ctx, cancel := context.WithTimeout(context.Background(), options.Timeout)
+	_, err := NewMapRDbServerClient(gateway.channel).Ping(ctx, &PingRequest{})
+	cancel()
+	gateway.reconnecting = false
+	if err == nil {
+		if !gateway.isHealthy() {
+			connection.logger.Log(context.Background(), slog.LevelInfo, "gateway is healthy", "target", gateway.target)
+		}
+		gateway.failures = 0
+		gateway.setHealthy(true)
+		return
+	}
+	gateway.failures++
+	connection.logger.Log(context.Background(), slog.LevelWarn, "health check failed",
+		"target", gateway.target, "failures", gateway.failures, "error", err)
+	if gateway.failures >= options.FailureThreshold {
+		gateway.setHealthy(false)
+	}
+	if options.ReconnectThreshold > 0 && gateway.failures%options.ReconnectThreshold == 0 {
+		if err := gateway.channel.redial(); err != nil {
+			connection.logger.Log(context.Background(), slog.LevelError, "reconnect failed",
+				"target", gateway.target, "error", err)
+			return
+		}
+		gateway.reconnecting = true
+	}
+}
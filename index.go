@@ -0,0 +1,125 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// IndexField indexed field of secondary index
+// Path OJAI FieldPath of the field
+// Order sort order of the field in the index
+type IndexField struct {
+	Path  string
+	Order Order
+}
+
+// IndexDescriptor parameters of secondary index
+// Name name of the index which is used in query hints and query plans
+// Fields indexed fields in the order of the index key
+// IncludedFields fields which are stored in the index, so queries projecting them don't read the table
+// Hashed whether the index key is hashed to distribute writes between regions
+type IndexDescriptor struct {
+	Name           string
+	Fields         []IndexField
+	IncludedFields []string
+	Hashed         bool
+}
+
+// CreateIndex method creates secondary index of the table, the index is filled by server in background.
+func (admin *Admin) CreateIndex(tablePath string, index *IndexDescriptor) error {
+	return admin.CreateIndexWithContext(tablePath, index, nil)
+}
+
+// CreateIndexWithContext method creates secondary index of the table, the index is filled by server in background.
+// User defined context is required for this method.
+func (admin *Admin) CreateIndexWithContext(tablePath string, index *IndexDescriptor, ctx context.Context) error {
+	request, err := index.toProto()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.CreateIndex(ctx,
+		&CreateIndexRequest{TablePath: tablePath, IndexSpec: request})
+	if err != nil {
+		return wrapRpcError(err)
+	}
+	return checkResponseErrorCode(response.GetError())
+}
+
+// DropIndex method drops secondary index of the table.
+func (admin *Admin) DropIndex(tablePath string, indexName string) error {
+	return admin.DropIndexWithContext(tablePath, indexName, nil)
+}
+
+// DropIndexWithContext method drops secondary index of the table.
+// User defined context is required for this method.
+func (admin *Admin) DropIndexWithContext(tablePath string, indexName string, ctx context.Context) error {
+	if len(indexName) == 0 {
+		return errors.New("index name can't be empty")
+	}
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.DropIndex(ctx,
+		&DropIndexRequest{TablePath: tablePath, Name: indexName})
+	if err != nil {
+		return wrapRpcError(err)
+	}
+	return checkResponseErrorCode(response.GetError())
+}
+
+// ListIndexes method returns secondary indexes of the table.
+func (admin *Admin) ListIndexes(tablePath string) ([]IndexDescriptor, error) {
+	return admin.ListIndexesWithContext(tablePath, nil)
+}
+
+// ListIndexesWithContext method returns secondary indexes of the table.
+// User defined context is required for this method.
+func (admin *Admin) ListIndexesWithContext(tablePath string, ctx context.Context) ([]IndexDescriptor, error) {
+	ctx, cancel := admin.callContext(ctx)
+	defer cancel()
+	response, err := admin.connection.stub.ListIndexes(ctx, &ListIndexesRequest{TablePath: tablePath})
+	if err != nil {
+		return nil, wrapRpcError(err)
+	}
+	if err = checkResponseErrorCode(response.GetError()); err != nil {
+		return nil, err
+	}
+	var indexes []IndexDescriptor
+	for _, spec := range response.GetIndexSpecs() {
+		indexes = append(indexes, indexDescriptorFromProto(spec))
+	}
+	return indexes, nil
+}
+
+// toProto method validates index descriptor and converts it into protobuf message
+func (index *IndexDescriptor) toProto() (*IndexSpec, error) {
+	if index == nil || len(index.Name) == 0 {
+		return nil, errors.New("index name can't be empty")
+	}
+	if len(index.Fields) == 0 {
+		return nil, fmt.Errorf("index %v must have at least one indexed field", index.Name)
+	}
+	result := &IndexSpec{Name: index.Name, IncludedFields: index.IncludedFields, Hashed: index.Hashed}
+	for _, field := range index.Fields {
+		if len(field.Path) == 0 {
+			return nil, fmt.Errorf("indexed field path of index %v can't be empty", index.Name)
+		}
+		result.Fields = append(result.Fields, &IndexFieldSpec{FieldPath: field.Path, Descending: field.Order == DESC})
+	}
+	return result, nil
+}
+
+// indexDescriptorFromProto converts protobuf message into IndexDescriptor
+func indexDescriptorFromProto(spec *IndexSpec) IndexDescriptor {
+	result := IndexDescriptor{Name: spec.GetName(), IncludedFields: spec.GetIncludedFields(), Hashed: spec.GetHashed()}
+	for _, field := range spec.GetFields() {
+		order := ASC
+		if field.GetDescending() {
+			order = DESC
+		}
+		result.Fields = append(result.Fields, IndexField{Path: field.GetFieldPath(), Order: order})
+	}
+	return result
+}
@@ -1,56 +1,50 @@
 package private_maprdb_go_client
 
 import (
-	"fmt"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
-// User metadata struct
-// encodedUserMetadata - base64 encoded username:password
-// token - unique JWT from server
-type userMetadata struct {
-	encodedUserMetadata, token string
+// withAuthorization returns context with authorization header replaced by given value
+func withAuthorization(ctx context.Context, authorization string) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set("authorization", authorization)
+	return metadata.NewOutgoingContext(ctx, md)
 }
 
-// UpdateToken method updates or set JWT token which must be present in gRPC request
-func (userMetadata *userMetadata) UpdateToken(header, trailer metadata.MD) {
-	if userMetadata.token == "" {
-		if val, ok := header["bearer-token"]; ok {
-			userMetadata.token = val[0]
-		}
+// reauthorize returns new authorization value if request was rejected by server
+// and AuthProvider has another credentials, otherwise it returns false.
+func reauthorize(ctx context.Context, provider AuthProvider, authorization string, err error) (string, bool) {
+	if status.Code(err) != codes.Unauthenticated {
+		return "", false
+	}
+	next, authErr := provider.Authorization(ctx)
+	if authErr != nil {
+		return "", false
 	}
+	if next == authorization {
+		provider.Done(next, nil, err)
+		return "", false
+	}
+	return next, true
 }
 
-// Closure function which returns custom UnaryClientInterceptor for channel
-func UnaryClientAuthInterceptor(umd *userMetadata) grpc.UnaryClientInterceptor {
-	return func(
-		ctx context.Context,
-		method string,
-		req interface{},
-		reply interface{},
-		cc *grpc.ClientConn,
-		invoker grpc.UnaryInvoker,
-		opts ...grpc.CallOption,
-	) error {
-		if umd.token == "" {
-			ctx = metadata.AppendToOutgoingContext(ctx,
-				"authorization",
-				fmt.Sprintf("basic %v", umd.encodedUserMetadata))
-		} else {
-			ctx = metadata.AppendToOutgoingContext(ctx,
-				"authorization",
-				fmt.Sprintf("bearer %v", umd.token))
-		}
-		err := invoker(ctx, method, req, reply, cc, opts...)
+// authenticationError converts Unauthenticated response into descriptive error
+func authenticationError(err error) error {
+	if status.Code(err) != codes.Unauthenticated {
 		return err
 	}
+	return status.Errorf(codes.Unauthenticated, "authentication failed on server. %v", status.Convert(err).Message())
 }
 
-// UnaryClientTokenInterceptor responsible for unauthenticated response code in Unary calls.
-func UnaryClientTokenInterceptor(umd *userMetadata) grpc.UnaryClientInterceptor {
+// Closure function which returns custom UnaryClientInterceptor for channel.
+// Interceptor attaches authorization metadata from AuthProvider and sends request again
+// if server rejected credentials and provider has new ones.
+func UnaryClientAuthInterceptor(provider AuthProvider) grpc.UnaryClientInterceptor {
 	return func(
 		ctx context.Context,
 		method string,
@@ -60,23 +54,32 @@ func UnaryClientTokenInterceptor(umd *userMetadata) grpc.UnaryClientInterceptor
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		err := invoker(ctx, method, req, reply, cc, opts...)
-		if grpc.Code(err) == codes.Unauthenticated {
-			if umd.token != "" {
-				umd.token = ""
-				ctx = metadata.AppendToOutgoingContext(ctx,
-					"authorization",
-					fmt.Sprintf("basic %v", umd.encodedUserMetadata))
-				err = invoker(ctx, method, req, reply, cc, opts...)
-			} else {
-				return fmt.Errorf("authentication PAM failed on server. %v", err)
+		authorization, err := provider.Authorization(ctx)
+		if err != nil {
+			return err
+		}
+		for retried := false; ; retried = true {
+			var header metadata.MD
+			err = invoker(withAuthorization(ctx, authorization), method, req, reply, cc,
+				append([]grpc.CallOption{grpc.Header(&header)}, opts...)...)
+			provider.Done(authorization, header, err)
+			if retried {
+				break
+			}
+			next, ok := reauthorize(ctx, provider, authorization, err)
+			if !ok {
+				break
 			}
+			authorization = next
 		}
-		return err
+		return authenticationError(err)
 	}
 }
 
-func StreamClientAuthInterceptor(umd *userMetadata) grpc.StreamClientInterceptor {
+// Closure function which returns custom StreamClientInterceptor for channel.
+// Interceptor attaches authorization metadata from AuthProvider and opens stream again
+// if server rejected credentials and provider has new ones.
+func StreamClientAuthInterceptor(provider AuthProvider) grpc.StreamClientInterceptor {
 	return func(
 		ctx context.Context,
 		desc *grpc.StreamDesc,
@@ -85,40 +88,27 @@ func StreamClientAuthInterceptor(umd *userMetadata) grpc.StreamClientInterceptor
 		streamer grpc.Streamer,
 		opts ...grpc.CallOption,
 	) (grpc.ClientStream, error) {
-		if umd.token == "" {
-			ctx = metadata.AppendToOutgoingContext(ctx,
-				"authorization",
-				fmt.Sprintf("basic %v", umd.encodedUserMetadata))
-		} else {
-			ctx = metadata.AppendToOutgoingContext(ctx,
-				"authorization",
-				fmt.Sprintf("bearer %v", umd.token))
+		authorization, err := provider.Authorization(ctx)
+		if err != nil {
+			return nil, err
 		}
-		return streamer(ctx, desc, cc, method, opts...)
-	}
-}
-
-func StreamClientTokenInterceptor(umd *userMetadata) grpc.StreamClientInterceptor {
-	return func(
-		ctx context.Context,
-		desc *grpc.StreamDesc,
-		cc *grpc.ClientConn,
-		method string,
-		streamer grpc.Streamer,
-		opts ...grpc.CallOption,
-	) (grpc.ClientStream, error) {
-		clientStream, err := streamer(ctx, desc, cc, method, opts...)
-		if grpc.Code(err) == codes.Unauthenticated {
-			if umd.token != "" {
-				umd.token = ""
-				ctx = metadata.AppendToOutgoingContext(ctx,
-					"authorization",
-					fmt.Sprintf("basic %v", umd.encodedUserMetadata))
-				clientStream, err = streamer(ctx, desc, cc, method, opts...)
-			} else {
-				return nil, fmt.Errorf("authentication PAM failed on server. %v", err)
+		for retried := false; ; retried = true {
+			clientStream, err := streamer(withAuthorization(ctx, authorization), desc, cc, method, opts...)
+			if err == nil {
+				// Header isn't awaited, since server may not send it until the first message,
+				// so login of the provider would block other requests for the lifetime of the stream
+				provider.Done(authorization, nil, nil)
+				return clientStream, nil
+			}
+			provider.Done(authorization, nil, err)
+			if retried {
+				return nil, authenticationError(err)
+			}
+			next, ok := reauthorize(ctx, provider, authorization, err)
+			if !ok {
+				return nil, authenticationError(err)
 			}
+			authorization = next
 		}
-		return clientStream, err
 	}
 }
@@ -0,0 +1,149 @@
+package private_maprdb_go_client
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func makeTestToken(claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return fmt.Sprintf("%v.%v.%v", encode([]byte(`{"alg":"HS256"}`)), encode([]byte(claims)), "signature")
+}
+
+// fakeAuthServer accepts basic credentials and the last issued token
+type fakeAuthServer struct {
+	mutex  sync.Mutex
+	token  string
+	logins int32
+}
+
+func (server *fakeAuthServer) invoke(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	opts ...grpc.CallOption,
+) error {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	authorization := md.Get("authorization")
+	if len(authorization) != 1 {
+		return status.Error(codes.InvalidArgument, "single authorization header expected")
+	}
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	switch authorization[0] {
+	case "basic dXNlcjpwYXNz":
+		atomic.AddInt32(&server.logins, 1)
+		time.Sleep(10 * time.Millisecond)
+		server.token = makeTestToken(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(time.Hour).Unix()))
+		for _, opt := range opts {
+			if header, ok := opt.(grpc.HeaderCallOption); ok {
+				*header.HeaderAddr = metadata.Pairs("bearer-token", server.token)
+			}
+		}
+		return nil
+	case "bearer " + server.token:
+		return nil
+	}
+	return status.Error(codes.Unauthenticated, "invalid credentials")
+}
+
+func TestUnaryClientInterceptors_SingleLogin(t *testing.T) {
+	server := &fakeAuthServer{}
+	provider := MakeBasicAuthProvider("user", "pass")
+	provider.token = "expired"
+	interceptor := UnaryClientAuthInterceptor(provider)
+	var wg sync.WaitGroup
+	errs := make([]error, 20)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = interceptor(context.Background(), "/Ping", nil, nil, nil, server.invoke)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.logins))
+
+	err := UnaryClientAuthInterceptor(MakeBasicAuthProvider("user", "wrong"))(
+		context.Background(), "/Ping", nil, nil, nil, server.invoke)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.True(t, errors.Is(wrapRpcError(err), ErrAccessDenied))
+
+	calls := 0
+	rotated := MakeCredentialsAuthProvider(func(ctx context.Context) (string, string, error) {
+		calls++
+		if calls == 1 {
+			return "user", "old", nil
+		}
+		return "user", "pass", nil
+	})
+	err = UnaryClientAuthInterceptor(rotated)(context.Background(), "/Ping", nil, nil, nil, server.invoke)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+
+	err = UnaryClientAuthInterceptor(MakeTokenAuthProvider(server.token))(
+		context.Background(), "/Ping", nil, nil, nil, server.invoke)
+	assert.Nil(t, err)
+}
+
+func TestStreamClientAuthInterceptor(t *testing.T) {
+	provider := MakeBasicAuthProvider("user", "pass")
+	provider.token = "expired"
+	var sent []string
+	streamer := func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		sent = append(sent, md.Get("authorization")...)
+		if md.Get("authorization")[0] == "bearer expired" {
+			return nil, status.Error(codes.Unauthenticated, "token expired")
+		}
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	_, err := StreamClientAuthInterceptor(provider)(context.Background(), &grpc.StreamDesc{}, nil, "/Find", streamer)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, []string{"bearer expired", "basic dXNlcjpwYXNz"}, sent)
+}
+
+func TestStreamClientAuthInterceptor_Login(t *testing.T) {
+	server := &fakeAuthServer{}
+	provider := MakeBasicAuthProvider("user", "pass")
+	streamer := func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return nil, nil
+	}
+	_, err := StreamClientAuthInterceptor(provider)(context.Background(), &grpc.StreamDesc{}, nil, "/Changes", streamer)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	interceptor := UnaryClientAuthInterceptor(provider)
+	assert.Nil(t, interceptor(ctx, "/Ping", nil, nil, nil, server.invoke))
+	assert.Nil(t, interceptor(ctx, "/Ping", nil, nil, nil, server.invoke))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.logins))
+}
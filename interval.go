@@ -0,0 +1,51 @@
+package private_maprdb_go_client
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OJAI interval representation, duration with millisecond precision
+type OInterval struct {
+	milliseconds int64
+}
+
+// MakeOIntervalFromMillis creates and returns interval of given number of milliseconds
+func MakeOIntervalFromMillis(milliseconds int64) *OInterval {
+	return &OInterval{milliseconds: milliseconds}
+}
+
+// MakeOIntervalFromDuration creates and returns interval from time.Duration truncated to milliseconds
+func MakeOIntervalFromDuration(duration time.Duration) *OInterval {
+	return &OInterval{milliseconds: duration.Milliseconds()}
+}
+
+// MakeOInterval creates and returns interval from days, seconds and milliseconds
+func MakeOInterval(days, seconds, millis int) *OInterval {
+	return &OInterval{milliseconds: (int64(days)*24*60*60+int64(seconds))*1000 + int64(millis)}
+}
+
+// Milliseconds returns total number of milliseconds of the interval
+func (interval *OInterval) Milliseconds() int64 {
+	return interval.milliseconds
+}
+
+// Days returns number of whole days of the interval
+func (interval *OInterval) Days() int {
+	return int(interval.milliseconds / (24 * 60 * 60 * 1000))
+}
+
+// Duration returns interval as time.Duration
+func (interval *OInterval) Duration() time.Duration {
+	return time.Duration(interval.milliseconds) * time.Millisecond
+}
+
+// Stringer interface implementation
+func (interval *OInterval) String() string {
+	return interval.Duration().String()
+}
+
+// Marshaller implementation for OInterval
+func (interval *OInterval) MarshalJSON() ([]byte, error) {
+	return json.Marshal(interval.milliseconds)
+}
@@ -0,0 +1,260 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Logger receives levelled structured events of the client, *slog.Logger implements it.
+// args are alternating keys and values or slog.Attr as in slog.Logger.Log.
+// Passwords and tokens are redacted before events are passed to Logger.
+type Logger interface {
+	Log(ctx context.Context, level slog.Level, msg string, args ...any)
+}
+
+// Replacement of redacted secrets
+const redacted = "[REDACTED]"
+
+// Keys of event attributes which values are always redacted
+var sensitiveKeys = []string{"password", "token", "authorization", "secret", "credentials"}
+
+// Patterns of credentials in strings, e.g. in errors or connection strings
+var sensitivePatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`(?i)\b(basic|bearer)\s+[A-Za-z0-9._~+/=-]+`), "$1 " + redacted},
+	{regexp.MustCompile(`(?i)\b(password|token|sslKeyPem|sslKey)=[^;&\s]*`), "$1=" + redacted},
+	{regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`), redacted},
+}
+
+// discardLogger drops all events, it's used if logger isn't set
+type discardLogger struct{}
+
+func (discardLogger) Log(context.Context, slog.Level, string, ...any) {}
+
+// redactingLogger removes passwords and tokens from events before passing them to Logger
+type redactingLogger struct {
+	logger Logger
+}
+
+// makeLogger returns logger which redacts secrets or logger which drops events if logger is nil
+func makeLogger(logger Logger) Logger {
+	if logger == nil {
+		return discardLogger{}
+	}
+	return &redactingLogger{logger: logger}
+}
+
+// Log method redacts message and attributes and passes them to wrapped Logger
+func (redactingLogger *redactingLogger) Log(ctx context.Context, level slog.Level, msg string, args ...any) {
+	redactedArgs := make([]any, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i].(type) {
+		case slog.Attr:
+			redactedArgs = append(redactedArgs, slog.Any(arg.Key, redactValue(arg.Key, arg.Value.Any())))
+		case string:
+			if i+1 == len(args) {
+				redactedArgs = append(redactedArgs, redactString(arg))
+				continue
+			}
+			redactedArgs = append(redactedArgs, arg, redactValue(arg, args[i+1]))
+			i++
+		default:
+			redactedArgs = append(redactedArgs, redactValue("", arg))
+		}
+	}
+	redactingLogger.logger.Log(ctx, level, redactString(msg), redactedArgs...)
+}
+
+// redactValue returns value of attribute with given key without secrets
+func redactValue(key string, value any) any {
+	lowerKey := strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(lowerKey, sensitive) {
+			return redacted
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return redactString(v)
+	case slog.Value:
+		if v.Kind() == slog.KindGroup {
+			return redactValue(key, v.Group())
+		}
+		return slog.AnyValue(redactValue(key, v.Any()))
+	case []slog.Attr:
+		// group attributes are redacted by their own keys
+		attrs := make([]slog.Attr, 0, len(v))
+		for _, attr := range v {
+			attrs = append(attrs, slog.Any(attr.Key, redactValue(attr.Key, attr.Value.Any())))
+		}
+		return slog.GroupValue(attrs...)
+	case error:
+		if message := redactString(v.Error()); message != v.Error() {
+			return message
+		}
+	}
+	return value
+}
+
+// redactString replaces credentials found in string
+func redactString(s string) string {
+	for _, sensitive := range sensitivePatterns {
+		s = sensitive.pattern.ReplaceAllString(s, sensitive.replacement)
+	}
+	return s
+}
+
+// requestTablePath returns table path of request or empty string
+func requestTablePath(req interface{}) string {
+	if request, ok := req.(interface{ GetTablePath() string }); ok {
+		return request.GetTablePath()
+	}
+	return ""
+}
+
+// Closure function which returns UnaryClientInterceptor logging failed calls and errors returned by server
+func UnaryClientLoggingInterceptor(logger Logger) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req interface{},
+		reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if callErr := CallError(err, reply); callErr != nil {
+			logger.Log(ctx, slog.LevelWarn, "call failed",
+				"method", method,
+				"table", requestTablePath(req),
+				"error_code", ErrorCodeOf(callErr).String(),
+				"error", callErr,
+				"duration", time.Since(start))
+		} else {
+			logger.Log(ctx, slog.LevelDebug, "call finished",
+				"method", method,
+				"table", requestTablePath(req),
+				"duration", time.Since(start))
+		}
+		return err
+	}
+}
+
+// Closure function which returns StreamClientInterceptor logging streams which failed to open
+// and errors returned by server in stream responses
+func StreamClientLoggingInterceptor(logger Logger) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		clientStream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			logger.Log(ctx, slog.LevelWarn, "call failed",
+				"method", method,
+				"error_code", ErrorCodeOf(wrapRpcError(err)).String(),
+				"error", err)
+			return nil, err
+		}
+		return &loggedClientStream{ClientStream: clientStream, logger: logger, ctx: ctx, method: method}, nil
+	}
+}
+
+// loggedClientStream logs errors returned by server in stream responses
+type loggedClientStream struct {
+	grpc.ClientStream
+	logger    Logger
+	ctx       context.Context
+	method    string
+	tablePath string
+}
+
+// SendMsg method remembers table path of request
+func (loggedStream *loggedClientStream) SendMsg(m interface{}) error {
+	loggedStream.tablePath = requestTablePath(m)
+	return loggedStream.ClientStream.SendMsg(m)
+}
+
+// RecvMsg method logs failed stream and error responses
+func (loggedStream *loggedClientStream) RecvMsg(m interface{}) error {
+	err := loggedStream.ClientStream.RecvMsg(m)
+	if err == io.EOF || loggedStream.ctx.Err() != nil {
+		// end of stream or stream closed by client
+		return err
+	}
+	if callErr := CallError(err, m); callErr != nil {
+		loggedStream.logger.Log(loggedStream.ctx, slog.LevelWarn, "call failed",
+			"method", loggedStream.method,
+			"table", loggedStream.tablePath,
+			"error_code", ErrorCodeOf(callErr).String(),
+			"error", callErr)
+	}
+	return err
+}
+
+// logRetry logs retry of failed attempt
+func logRetry(logger Logger) func(ctx context.Context, attempt RetryAttempt) {
+	return func(ctx context.Context, attempt RetryAttempt) {
+		logger.Log(ctx, slog.LevelInfo, "retrying call",
+			"method", attempt.Method,
+			"attempt", attempt.Attempt,
+			"delay", attempt.Delay,
+			"code", status.Code(attempt.Err).String(),
+			"error", attempt.Err)
+	}
+}
+
+// loggedAuthProvider logs token acquisition, refresh and authentication failures
+type loggedAuthProvider struct {
+	AuthProvider
+	logger Logger
+}
+
+// Authorization method logs login with credentials and failures to get authorization
+func (loggedProvider *loggedAuthProvider) Authorization(ctx context.Context) (string, error) {
+	authorization, err := loggedProvider.AuthProvider.Authorization(ctx)
+	if err != nil {
+		loggedProvider.logger.Log(ctx, slog.LevelError, "failed to get authorization", "error", err)
+	} else if strings.HasPrefix(authorization, "basic ") {
+		loggedProvider.logger.Log(ctx, slog.LevelDebug, "authenticating with user credentials")
+	}
+	return authorization, err
+}
+
+// Done method logs token issued by server and rejected credentials
+func (loggedProvider *loggedAuthProvider) Done(authorization string, header metadata.MD, err error) {
+	scheme := strings.SplitN(authorization, " ", 2)[0]
+	if tokens := header.Get("bearer-token"); err == nil && len(tokens) != 0 && "bearer "+tokens[0] != authorization {
+		args := []any{"scheme", scheme}
+		if expiresAt := tokenExpiration(tokens[0]); !expiresAt.IsZero() {
+			args = append(args, "expires_at", expiresAt)
+		}
+		message := "authentication token refreshed"
+		if scheme == "basic" {
+			message = "authentication token acquired"
+		}
+		loggedProvider.logger.Log(context.Background(), slog.LevelInfo, message, args...)
+	}
+	if status.Code(err) == codes.Unauthenticated {
+		loggedProvider.logger.Log(context.Background(), slog.LevelWarn, "authentication failed",
+			"scheme", scheme,
+			"error", status.Convert(err).Message())
+	}
+	loggedProvider.AuthProvider.Done(authorization, header, err)
+}
@@ -0,0 +1,103 @@
+package private_maprdb_go_client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// makeTestLogger returns redacting logger which writes text records to buffer
+func makeTestLogger() (Logger, *bytes.Buffer) {
+	buffer := &bytes.Buffer{}
+	handler := slog.NewTextHandler(buffer, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	return makeLogger(slog.New(handler)), buffer
+}
+
+func TestRedactingLogger(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		args []any
+		want string
+	}{
+		{"sensitive key", "login", []any{"user", "mapr", "password", "secret"},
+			`level=INFO msg=login user=mapr password=[REDACTED]`},
+		{"sensitive attr", "login", []any{slog.String("bearerToken", "abc")},
+			`level=INFO msg=login bearerToken=[REDACTED]`},
+		{"authorization in error", "failed", []any{"error", errors.New("rejected basic dXNlcjpwYXNz")},
+			`level=INFO msg=failed error="rejected basic [REDACTED]"`},
+		{"connection string", "connecting", []any{"target", "ojai:mapr@host:5678?user=m;password=p%3B;token=t"},
+			`level=INFO msg=connecting target="ojai:mapr@host:5678?user=m;password=[REDACTED];token=[REDACTED]"`},
+		{"jwt in message", "got eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiJ1In0.c2ln", nil,
+			`level=INFO msg="got [REDACTED]"`},
+		{"group attr", "login", []any{slog.Group("auth", "user", "mapr", "password", "secret",
+			slog.Group("header", "authorization", "basic dXNlcjpwYXNz", "error", errors.New("rejected basic dXNlcjpwYXNz")))},
+			`level=INFO msg=login auth.user=mapr auth.password=[REDACTED] auth.header.authorization=[REDACTED] ` +
+				`auth.header.error="rejected basic [REDACTED]"`},
+		{"group value", "login", []any{"auth", slog.GroupValue(slog.String("token", "abc"), slog.Int("attempt", 1))},
+			`level=INFO msg=login auth.token=[REDACTED] auth.attempt=1`},
+		{"non-sensitive values", "call", []any{"attempt", 2, "error", errors.New("unavailable")},
+			`level=INFO msg=call attempt=2 error=unavailable`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, buffer := makeTestLogger()
+			logger.Log(context.Background(), slog.LevelInfo, tt.msg, tt.args...)
+			assert.Equal(t, tt.want, strings.TrimSpace(buffer.String()))
+		})
+	}
+	makeLogger(nil).Log(context.Background(), slog.LevelError, "dropped")
+}
+
+func TestLoggedAuthProvider(t *testing.T) {
+	logger, buffer := makeTestLogger()
+	provider := &loggedAuthProvider{AuthProvider: MakeBasicAuthProvider("user", "pass"), logger: logger}
+	authorization, err := provider.Authorization(context.Background())
+	assert.Nil(t, err)
+	token := makeTestToken(`{"exp":1700000000}`)
+	provider.Done(authorization, metadata.Pairs("bearer-token", token), nil)
+	provider.Done("bearer "+token, nil, status.Error(codes.Unauthenticated, "token expired"))
+
+	output := buffer.String()
+	assert.Contains(t, output, `level=DEBUG msg="authenticating with user credentials"`)
+	assert.Contains(t, output, `level=INFO msg="authentication token acquired" scheme=basic expires_at=`)
+	assert.Contains(t, output, `level=WARN msg="authentication failed" scheme=bearer error="token expired"`)
+	assert.NotContains(t, output, token)
+	assert.NotContains(t, output, "dXNlcjpwYXNz")
+}
+
+func TestUnaryClientLoggingInterceptor(t *testing.T) {
+	logger, buffer := makeTestLogger()
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		reply.(*InsertOrReplaceResponse).Error = &RpcError{ErrCode: ErrorCode_DOCUMENT_ALREADY_EXISTS, ErrorMessage: "exists"}
+		return nil
+	}
+	err := UnaryClientLoggingInterceptor(logger)(context.Background(), "/com.mapr.data.db.MapRDbServer/InsertOrReplace",
+		&InsertOrReplaceRequest{TablePath: "/t"}, &InsertOrReplaceResponse{}, nil, invoker)
+	assert.Nil(t, err)
+	assert.Contains(t, buffer.String(), `level=WARN msg="call failed" method=/com.mapr.data.db.MapRDbServer/InsertOrReplace `+
+		`table=/t error_code=DOCUMENT_ALREADY_EXISTS error="DOCUMENT_ALREADY_EXISTS: exists"`)
+
+	buffer.Reset()
+	err = UnaryClientLoggingInterceptor(logger)(context.Background(), "/com.mapr.data.db.MapRDbServer/Ping",
+		&PingRequest{}, &PingResponse{}, nil, failingInvoker(new(int)))
+	assert.Nil(t, err)
+	assert.Contains(t, buffer.String(), `level=DEBUG msg="call finished" method=/com.mapr.data.db.MapRDbServer/Ping`)
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
+// 	protoc-gen-go v1.27.1
 // 	protoc        v3.14.0
 // source: maprdb-server.proto
 
@@ -20,7 +20,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-//*
+// *
 // RPC response error codes. POSIX error codes are used where appropriate.
 //
 // Extended error codes, those that can not be mapped to a POSIX error code, begins at 256.
@@ -141,7 +141,7 @@ func (ErrorCode) EnumDescriptor() ([]byte, []int) {
 	return file_maprdb_server_proto_rawDescGZIP(), []int{0}
 }
 
-//*
+// *
 // ENUM indicating the encoding scheme of the OJAI objects in RPC request/response.
 // Currently only JSON encoding is supported.
 type PayloadEncoding int32
@@ -309,7 +309,67 @@ func (FindResponseType) EnumDescriptor() ([]byte, []int) {
 	return file_maprdb_server_proto_rawDescGZIP(), []int{3}
 }
 
-//*
+type ChangeRecordType int32
+
+const (
+	//*
+	// Invalid, unknown type
+	ChangeRecordType_UNKNOWN_CHANGE_TYPE ChangeRecordType = 0
+	//*
+	// A new document was inserted into the table
+	ChangeRecordType_CHANGE_INSERT ChangeRecordType = 1
+	//*
+	// An existing document was updated or replaced
+	ChangeRecordType_CHANGE_UPDATE ChangeRecordType = 2
+	//*
+	// A document was deleted from the table
+	ChangeRecordType_CHANGE_DELETE ChangeRecordType = 3
+)
+
+// Enum value maps for ChangeRecordType.
+var (
+	ChangeRecordType_name = map[int32]string{
+		0: "UNKNOWN_CHANGE_TYPE",
+		1: "CHANGE_INSERT",
+		2: "CHANGE_UPDATE",
+		3: "CHANGE_DELETE",
+	}
+	ChangeRecordType_value = map[string]int32{
+		"UNKNOWN_CHANGE_TYPE": 0,
+		"CHANGE_INSERT":       1,
+		"CHANGE_UPDATE":       2,
+		"CHANGE_DELETE":       3,
+	}
+)
+
+func (x ChangeRecordType) Enum() *ChangeRecordType {
+	p := new(ChangeRecordType)
+	*p = x
+	return p
+}
+
+func (x ChangeRecordType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeRecordType) Descriptor() protoreflect.EnumDescriptor {
+	return file_maprdb_server_proto_enumTypes[4].Descriptor()
+}
+
+func (ChangeRecordType) Type() protoreflect.EnumType {
+	return &file_maprdb_server_proto_enumTypes[4]
+}
+
+func (x ChangeRecordType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeRecordType.Descriptor instead.
+func (ChangeRecordType) EnumDescriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{4}
+}
+
+// *
 // Protobuf message that encapsulates RPC operation error, if any.
 // Each RPC response should include RpcError message, with `NO_ERROR` indicating success
 type RpcError struct {
@@ -748,24 +808,27 @@ func (x *TableExistsResponse) GetError() *RpcError {
 	return nil
 }
 
-type InsertOrReplaceRequest struct {
+// *
+// Column family of JSON table, stores the subtree of documents rooted at `json_path`
+type ColumnFamilySpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TablePath       string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
-	InsertMode      InsertMode      `protobuf:"varint,2,opt,name=insert_mode,json=insertMode,proto3,enum=com.mapr.data.db.InsertMode" json:"insert_mode,omitempty"`
-	PayloadEncoding PayloadEncoding `protobuf:"varint,3,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
-	// Types that are assignable to Condition:
-	//	*InsertOrReplaceRequest_JsonCondition
-	Condition isInsertOrReplaceRequest_Condition `protobuf_oneof:"condition"`
-	// Types that are assignable to Data:
-	//	*InsertOrReplaceRequest_JsonDocument
-	Data isInsertOrReplaceRequest_Data `protobuf_oneof:"data"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//*
+	// OJAI FieldPath of the family root, empty for the default family
+	JsonPath string `protobuf:"bytes,2,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
+	//*
+	// Time to live of the family data in seconds, 0 means that data never expires
+	Ttl int64 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	//*
+	// Whether the family data should be kept in memory
+	InMemory bool `protobuf:"varint,4,opt,name=in_memory,json=inMemory,proto3" json:"in_memory,omitempty"`
 }
 
-func (x *InsertOrReplaceRequest) Reset() {
-	*x = InsertOrReplaceRequest{}
+func (x *ColumnFamilySpec) Reset() {
+	*x = ColumnFamilySpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_maprdb_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -773,13 +836,13 @@ func (x *InsertOrReplaceRequest) Reset() {
 	}
 }
 
-func (x *InsertOrReplaceRequest) String() string {
+func (x *ColumnFamilySpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertOrReplaceRequest) ProtoMessage() {}
+func (*ColumnFamilySpec) ProtoMessage() {}
 
-func (x *InsertOrReplaceRequest) ProtoReflect() protoreflect.Message {
+func (x *ColumnFamilySpec) ProtoReflect() protoreflect.Message {
 	mi := &file_maprdb_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -791,112 +854,154 @@ func (x *InsertOrReplaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertOrReplaceRequest.ProtoReflect.Descriptor instead.
-func (*InsertOrReplaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColumnFamilySpec.ProtoReflect.Descriptor instead.
+func (*ColumnFamilySpec) Descriptor() ([]byte, []int) {
 	return file_maprdb_server_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *InsertOrReplaceRequest) GetTablePath() string {
+func (x *ColumnFamilySpec) GetName() string {
 	if x != nil {
-		return x.TablePath
+		return x.Name
 	}
 	return ""
 }
 
-func (x *InsertOrReplaceRequest) GetInsertMode() InsertMode {
+func (x *ColumnFamilySpec) GetJsonPath() string {
 	if x != nil {
-		return x.InsertMode
+		return x.JsonPath
 	}
-	return InsertMode_UNKNOWN_MODE
+	return ""
 }
 
-func (x *InsertOrReplaceRequest) GetPayloadEncoding() PayloadEncoding {
+func (x *ColumnFamilySpec) GetTtl() int64 {
 	if x != nil {
-		return x.PayloadEncoding
+		return x.Ttl
 	}
-	return PayloadEncoding_UNKNOWN_ENCODING
+	return 0
 }
 
-func (m *InsertOrReplaceRequest) GetCondition() isInsertOrReplaceRequest_Condition {
-	if m != nil {
-		return m.Condition
+func (x *ColumnFamilySpec) GetInMemory() bool {
+	if x != nil {
+		return x.InMemory
 	}
-	return nil
+	return false
 }
 
-func (x *InsertOrReplaceRequest) GetJsonCondition() string {
-	if x, ok := x.GetCondition().(*InsertOrReplaceRequest_JsonCondition); ok {
-		return x.JsonCondition
-	}
-	return ""
+// *
+// Parameters of JSON table
+type TableSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath string `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	//*
+	// Whether regions of the table are split automatically when they grow
+	AutoSplit bool `protobuf:"varint,2,opt,name=auto_split,json=autoSplit,proto3" json:"auto_split,omitempty"`
+	//*
+	// Size of region in MB at which it is split, 0 means the server default
+	SplitSize int64 `protobuf:"varint,3,opt,name=split_size,json=splitSize,proto3" json:"split_size,omitempty"`
+	//*
+	// Whether the table is in bulk load mode
+	BulkLoad bool `protobuf:"varint,4,opt,name=bulk_load,json=bulkLoad,proto3" json:"bulk_load,omitempty"`
+	//*
+	// Column families of the table, the default family is reported by DescribeTable
+	// and may be specified to set its parameters
+	ColumnFamilies []*ColumnFamilySpec `protobuf:"bytes,5,rep,name=column_families,json=columnFamilies,proto3" json:"column_families,omitempty"`
 }
 
-func (m *InsertOrReplaceRequest) GetData() isInsertOrReplaceRequest_Data {
-	if m != nil {
-		return m.Data
+func (x *TableSpec) Reset() {
+	*x = TableSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *InsertOrReplaceRequest) GetJsonDocument() string {
-	if x, ok := x.GetData().(*InsertOrReplaceRequest_JsonDocument); ok {
-		return x.JsonDocument
+func (x *TableSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableSpec) ProtoMessage() {}
+
+func (x *TableSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type isInsertOrReplaceRequest_Condition interface {
-	isInsertOrReplaceRequest_Condition()
+// Deprecated: Use TableSpec.ProtoReflect.Descriptor instead.
+func (*TableSpec) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{10}
 }
 
-type InsertOrReplaceRequest_JsonCondition struct {
-	//*
-	// <b>[Optional]</b><p/>
-	// Contains JSON encoded OJAI QueryCondition when payload_encoding is `JSON_ENCODING`.<p/>
-	// This should only be specified if the `insert_mode` == REPLACE
-	JsonCondition string `protobuf:"bytes,4,opt,name=json_condition,json=jsonCondition,proto3,oneof"`
+func (x *TableSpec) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
 }
 
-func (*InsertOrReplaceRequest_JsonCondition) isInsertOrReplaceRequest_Condition() {}
+func (x *TableSpec) GetAutoSplit() bool {
+	if x != nil {
+		return x.AutoSplit
+	}
+	return false
+}
 
-type isInsertOrReplaceRequest_Data interface {
-	isInsertOrReplaceRequest_Data()
+func (x *TableSpec) GetSplitSize() int64 {
+	if x != nil {
+		return x.SplitSize
+	}
+	return 0
 }
 
-type InsertOrReplaceRequest_JsonDocument struct {
-	//*
-	// <b>[Required]</b><p/>
-	// Contains JSON encoded OJAI Document if the payload_encoding is `JSON_ENCODING`
-	JsonDocument string `protobuf:"bytes,30,opt,name=json_document,json=jsonDocument,proto3,oneof"`
+func (x *TableSpec) GetBulkLoad() bool {
+	if x != nil {
+		return x.BulkLoad
+	}
+	return false
 }
 
-func (*InsertOrReplaceRequest_JsonDocument) isInsertOrReplaceRequest_Data() {}
+func (x *TableSpec) GetColumnFamilies() []*ColumnFamilySpec {
+	if x != nil {
+		return x.ColumnFamilies
+	}
+	return nil
+}
 
-type InsertOrReplaceResponse struct {
+type CreateTableWithDescriptorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	TableSpec *TableSpec `protobuf:"bytes,1,opt,name=table_spec,json=tableSpec,proto3" json:"table_spec,omitempty"`
 }
 
-func (x *InsertOrReplaceResponse) Reset() {
-	*x = InsertOrReplaceResponse{}
+func (x *CreateTableWithDescriptorRequest) Reset() {
+	*x = CreateTableWithDescriptorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_maprdb_server_proto_msgTypes[10]
+		mi := &file_maprdb_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InsertOrReplaceResponse) String() string {
+func (x *CreateTableWithDescriptorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertOrReplaceResponse) ProtoMessage() {}
+func (*CreateTableWithDescriptorRequest) ProtoMessage() {}
 
-func (x *InsertOrReplaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_maprdb_server_proto_msgTypes[10]
+func (x *CreateTableWithDescriptorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,54 +1012,46 @@ func (x *InsertOrReplaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertOrReplaceResponse.ProtoReflect.Descriptor instead.
-func (*InsertOrReplaceResponse) Descriptor() ([]byte, []int) {
-	return file_maprdb_server_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreateTableWithDescriptorRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableWithDescriptorRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *InsertOrReplaceResponse) GetError() *RpcError {
+func (x *CreateTableWithDescriptorRequest) GetTableSpec() *TableSpec {
 	if x != nil {
-		return x.Error
+		return x.TableSpec
 	}
 	return nil
 }
 
-type FindByIdRequest struct {
+type CreateTableWithDescriptorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TablePath       string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
-	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
 	//*
-	// <b>[Optional]</b><p/>
-	// List of OJAI FieldPaths that should be included in the returned document
-	Projections []string `protobuf:"bytes,3,rep,name=projections,proto3" json:"projections,omitempty"`
-	// Types that are assignable to Condition:
-	//	*FindByIdRequest_JsonCondition
-	Condition isFindByIdRequest_Condition `protobuf_oneof:"condition"`
-	// Types that are assignable to Document:
-	//	*FindByIdRequest_JsonDocument
-	Document isFindByIdRequest_Document `protobuf_oneof:"document"`
+	// `NO_ERROR` - if the table was created successfully,
+	// `TABLE_ALREADY_EXISTS` - if a table with the same path already exists
+	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *FindByIdRequest) Reset() {
-	*x = FindByIdRequest{}
+func (x *CreateTableWithDescriptorResponse) Reset() {
+	*x = CreateTableWithDescriptorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_maprdb_server_proto_msgTypes[11]
+		mi := &file_maprdb_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindByIdRequest) String() string {
+func (x *CreateTableWithDescriptorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindByIdRequest) ProtoMessage() {}
+func (*CreateTableWithDescriptorResponse) ProtoMessage() {}
 
-func (x *FindByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_maprdb_server_proto_msgTypes[11]
+func (x *CreateTableWithDescriptorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,118 +1062,96 @@ func (x *FindByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindByIdRequest.ProtoReflect.Descriptor instead.
-func (*FindByIdRequest) Descriptor() ([]byte, []int) {
-	return file_maprdb_server_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CreateTableWithDescriptorResponse.ProtoReflect.Descriptor instead.
+func (*CreateTableWithDescriptorResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *FindByIdRequest) GetTablePath() string {
+func (x *CreateTableWithDescriptorResponse) GetError() *RpcError {
 	if x != nil {
-		return x.TablePath
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-func (x *FindByIdRequest) GetPayloadEncoding() PayloadEncoding {
-	if x != nil {
-		return x.PayloadEncoding
-	}
-	return PayloadEncoding_UNKNOWN_ENCODING
+type ListTablesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// Directory which tables are listed
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *FindByIdRequest) GetProjections() []string {
-	if x != nil {
-		return x.Projections
+func (x *ListTablesRequest) Reset() {
+	*x = ListTablesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (m *FindByIdRequest) GetCondition() isFindByIdRequest_Condition {
-	if m != nil {
-		return m.Condition
-	}
-	return nil
+func (x *ListTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FindByIdRequest) GetJsonCondition() string {
-	if x, ok := x.GetCondition().(*FindByIdRequest_JsonCondition); ok {
-		return x.JsonCondition
+func (*ListTablesRequest) ProtoMessage() {}
+
+func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (m *FindByIdRequest) GetDocument() isFindByIdRequest_Document {
-	if m != nil {
-		return m.Document
-	}
-	return nil
+// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListTablesRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *FindByIdRequest) GetJsonDocument() string {
-	if x, ok := x.GetDocument().(*FindByIdRequest_JsonDocument); ok {
-		return x.JsonDocument
+func (x *ListTablesRequest) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-type isFindByIdRequest_Condition interface {
-	isFindByIdRequest_Condition()
-}
+type ListTablesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type FindByIdRequest_JsonCondition struct {
 	//*
-	// <b>[Optional]</b><p/>
-	// Contains JSON encoded OJAI QueryCondition when payload_encoding is `JSON_ENCODING`.<p/>
-	JsonCondition string `protobuf:"bytes,4,opt,name=json_condition,json=jsonCondition,proto3,oneof"`
+	// `NO_ERROR` - if the directory exists
+	// `PATH_NOT_FOUND` - if the directory does not exist
+	Error      *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	TablePaths []string  `protobuf:"bytes,2,rep,name=table_paths,json=tablePaths,proto3" json:"table_paths,omitempty"`
 }
 
-func (*FindByIdRequest_JsonCondition) isFindByIdRequest_Condition() {}
+func (x *ListTablesResponse) Reset() {
+	*x = ListTablesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-type isFindByIdRequest_Document interface {
-	isFindByIdRequest_Document()
+func (x *ListTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type FindByIdRequest_JsonDocument struct {
-	//*
-	// <b>[Required]</b><p/>
-	// Contains JSON encoded OJAI Document with `_id` field when payload_encoding is `JSON_ENCODING`.<p/>
-	JsonDocument string `protobuf:"bytes,5,opt,name=json_document,json=jsonDocument,proto3,oneof"`
-}
-
-func (*FindByIdRequest_JsonDocument) isFindByIdRequest_Document() {}
-
-type FindByIdResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//*
-	// `NO_ERROR` - if a document with the specified `_id` was found
-	// `DOCUMENT_NOT_FOUND` - if the document with the specified `_id` does not exist
-	Error           *RpcError       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
-	// Types that are assignable to Data:
-	//	*FindByIdResponse_JsonDocument
-	Data isFindByIdResponse_Data `protobuf_oneof:"data"`
-}
-
-func (x *FindByIdResponse) Reset() {
-	*x = FindByIdResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_maprdb_server_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
+func (*ListTablesResponse) ProtoMessage() {}
 
-func (x *FindByIdResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FindByIdResponse) ProtoMessage() {}
-
-func (x *FindByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_maprdb_server_proto_msgTypes[12]
+func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1087,82 +1162,50 @@ func (x *FindByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindByIdResponse.ProtoReflect.Descriptor instead.
-func (*FindByIdResponse) Descriptor() ([]byte, []int) {
-	return file_maprdb_server_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
+func (*ListTablesResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *FindByIdResponse) GetError() *RpcError {
+func (x *ListTablesResponse) GetError() *RpcError {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-func (x *FindByIdResponse) GetPayloadEncoding() PayloadEncoding {
+func (x *ListTablesResponse) GetTablePaths() []string {
 	if x != nil {
-		return x.PayloadEncoding
-	}
-	return PayloadEncoding_UNKNOWN_ENCODING
-}
-
-func (m *FindByIdResponse) GetData() isFindByIdResponse_Data {
-	if m != nil {
-		return m.Data
+		return x.TablePaths
 	}
 	return nil
 }
 
-func (x *FindByIdResponse) GetJsonDocument() string {
-	if x, ok := x.GetData().(*FindByIdResponse_JsonDocument); ok {
-		return x.JsonDocument
-	}
-	return ""
-}
-
-type isFindByIdResponse_Data interface {
-	isFindByIdResponse_Data()
-}
-
-type FindByIdResponse_JsonDocument struct {
-	//*
-	// <b>[Required]</b><p/>
-	// Contains JSON encoded OJAI Document if the payload_encoding is `JSON_ENCODING`
-	JsonDocument string `protobuf:"bytes,30,opt,name=json_document,json=jsonDocument,proto3,oneof"`
-}
-
-func (*FindByIdResponse_JsonDocument) isFindByIdResponse_Data() {}
-
-type FindRequest struct {
+type DescribeTableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TablePath        string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
-	PayloadEncoding  PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
-	IncludeQueryPlan bool            `protobuf:"varint,3,opt,name=include_query_plan,json=includeQueryPlan,proto3" json:"include_query_plan,omitempty"`
-	// Types that are assignable to Data:
-	//	*FindRequest_JsonQuery
-	Data isFindRequest_Data `protobuf_oneof:"data"`
+	TablePath string `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
 }
 
-func (x *FindRequest) Reset() {
-	*x = FindRequest{}
+func (x *DescribeTableRequest) Reset() {
+	*x = DescribeTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_maprdb_server_proto_msgTypes[13]
+		mi := &file_maprdb_server_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindRequest) String() string {
+func (x *DescribeTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindRequest) ProtoMessage() {}
+func (*DescribeTableRequest) ProtoMessage() {}
 
-func (x *FindRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_maprdb_server_proto_msgTypes[13]
+func (x *DescribeTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,95 +1216,103 @@ func (x *FindRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindRequest.ProtoReflect.Descriptor instead.
-func (*FindRequest) Descriptor() ([]byte, []int) {
-	return file_maprdb_server_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DescribeTableRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTableRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *FindRequest) GetTablePath() string {
+func (x *DescribeTableRequest) GetTablePath() string {
 	if x != nil {
 		return x.TablePath
 	}
 	return ""
 }
 
-func (x *FindRequest) GetPayloadEncoding() PayloadEncoding {
-	if x != nil {
-		return x.PayloadEncoding
-	}
-	return PayloadEncoding_UNKNOWN_ENCODING
+type DescribeTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if the table exists
+	// `TABLE_NOT_FOUND` - if the table does not exist
+	Error     *RpcError  `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	TableSpec *TableSpec `protobuf:"bytes,2,opt,name=table_spec,json=tableSpec,proto3" json:"table_spec,omitempty"`
 }
 
-func (x *FindRequest) GetIncludeQueryPlan() bool {
-	if x != nil {
-		return x.IncludeQueryPlan
+func (x *DescribeTableResponse) Reset() {
+	*x = DescribeTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (m *FindRequest) GetData() isFindRequest_Data {
-	if m != nil {
-		return m.Data
-	}
-	return nil
+func (x *DescribeTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FindRequest) GetJsonQuery() string {
-	if x, ok := x.GetData().(*FindRequest_JsonQuery); ok {
-		return x.JsonQuery
+func (*DescribeTableResponse) ProtoMessage() {}
+
+func (x *DescribeTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type isFindRequest_Data interface {
-	isFindRequest_Data()
+// Deprecated: Use DescribeTableResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTableResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{16}
 }
 
-type FindRequest_JsonQuery struct {
-	//*
-	// <b>[Required]</b><p/>
-	// Contains JSON encoded OJAI Query if the payload_encoding is `JSON_ENCODING`
-	JsonQuery string `protobuf:"bytes,4,opt,name=json_query,json=jsonQuery,proto3,oneof"`
+func (x *DescribeTableResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
 }
 
-func (*FindRequest_JsonQuery) isFindRequest_Data() {}
+func (x *DescribeTableResponse) GetTableSpec() *TableSpec {
+	if x != nil {
+		return x.TableSpec
+	}
+	return nil
+}
 
-//*
-// Results of Find() RPCs are streamed to the clients, with each FindResponse containing
-// one OJAI document. If the `include_query_plan` in FindRequest was set to true, the first
-// FindResponse will contain the query plan instead of OJAI Document
-type FindResponse struct {
+type AlterTableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error           *RpcError       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
 	//*
-	// Indicates the type of this response
-	Type FindResponseType `protobuf:"varint,3,opt,name=type,proto3,enum=com.mapr.data.db.FindResponseType" json:"type,omitempty"`
-	// Types that are assignable to Data:
-	//	*FindResponse_JsonResponse
-	Data isFindResponse_Data `protobuf_oneof:"data"`
+	// New parameters of the table, column families are not changed
+	TableSpec *TableSpec `protobuf:"bytes,1,opt,name=table_spec,json=tableSpec,proto3" json:"table_spec,omitempty"`
 }
 
-func (x *FindResponse) Reset() {
-	*x = FindResponse{}
+func (x *AlterTableRequest) Reset() {
+	*x = AlterTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_maprdb_server_proto_msgTypes[14]
+		mi := &file_maprdb_server_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindResponse) String() string {
+func (x *AlterTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindResponse) ProtoMessage() {}
+func (*AlterTableRequest) ProtoMessage() {}
 
-func (x *FindResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_maprdb_server_proto_msgTypes[14]
+func (x *AlterTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,93 +1323,94 @@ func (x *FindResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindResponse.ProtoReflect.Descriptor instead.
-func (*FindResponse) Descriptor() ([]byte, []int) {
-	return file_maprdb_server_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AlterTableRequest.ProtoReflect.Descriptor instead.
+func (*AlterTableRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *FindResponse) GetError() *RpcError {
+func (x *AlterTableRequest) GetTableSpec() *TableSpec {
 	if x != nil {
-		return x.Error
+		return x.TableSpec
 	}
 	return nil
 }
 
-func (x *FindResponse) GetPayloadEncoding() PayloadEncoding {
-	if x != nil {
-		return x.PayloadEncoding
-	}
-	return PayloadEncoding_UNKNOWN_ENCODING
+type AlterTableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if the table was altered successfully
+	// `TABLE_NOT_FOUND` - if the table does not exist
+	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *FindResponse) GetType() FindResponseType {
-	if x != nil {
-		return x.Type
+func (x *AlterTableResponse) Reset() {
+	*x = AlterTableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return FindResponseType_UNKNOWN_TYPE
 }
 
-func (m *FindResponse) GetData() isFindResponse_Data {
-	if m != nil {
-		return m.Data
-	}
-	return nil
+func (x *AlterTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FindResponse) GetJsonResponse() string {
-	if x, ok := x.GetData().(*FindResponse_JsonResponse); ok {
-		return x.JsonResponse
+func (*AlterTableResponse) ProtoMessage() {}
+
+func (x *AlterTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type isFindResponse_Data interface {
-	isFindResponse_Data()
+// Deprecated: Use AlterTableResponse.ProtoReflect.Descriptor instead.
+func (*AlterTableResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{18}
 }
 
-type FindResponse_JsonResponse struct {
-	//*
-	// Contains JSON encoded response if the payload_encoding is `JSON_ENCODING`
-	JsonResponse string `protobuf:"bytes,30,opt,name=json_response,json=jsonResponse,proto3,oneof"`
+func (x *AlterTableResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
 }
 
-func (*FindResponse_JsonResponse) isFindResponse_Data() {}
-
-type UpdateRequest struct {
+type AddColumnFamilyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TablePath       string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
-	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
-	// Types that are assignable to Document:
-	//	*UpdateRequest_JsonDocument
-	Document isUpdateRequest_Document `protobuf_oneof:"document"`
-	// Types that are assignable to Condition:
-	//	*UpdateRequest_JsonCondition
-	Condition isUpdateRequest_Condition `protobuf_oneof:"condition"`
-	// Types that are assignable to Mutation:
-	//	*UpdateRequest_JsonMutation
-	Mutation isUpdateRequest_Mutation `protobuf_oneof:"mutation"`
+	TablePath    string            `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	ColumnFamily *ColumnFamilySpec `protobuf:"bytes,2,opt,name=column_family,json=columnFamily,proto3" json:"column_family,omitempty"`
 }
 
-func (x *UpdateRequest) Reset() {
-	*x = UpdateRequest{}
+func (x *AddColumnFamilyRequest) Reset() {
+	*x = AddColumnFamilyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_maprdb_server_proto_msgTypes[15]
+		mi := &file_maprdb_server_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateRequest) String() string {
+func (x *AddColumnFamilyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRequest) ProtoMessage() {}
+func (*AddColumnFamilyRequest) ProtoMessage() {}
 
-func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_maprdb_server_proto_msgTypes[15]
+func (x *AddColumnFamilyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1369,135 +1421,162 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_maprdb_server_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AddColumnFamilyRequest.ProtoReflect.Descriptor instead.
+func (*AddColumnFamilyRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateRequest) GetTablePath() string {
+func (x *AddColumnFamilyRequest) GetTablePath() string {
 	if x != nil {
 		return x.TablePath
 	}
 	return ""
 }
 
-func (x *UpdateRequest) GetPayloadEncoding() PayloadEncoding {
+func (x *AddColumnFamilyRequest) GetColumnFamily() *ColumnFamilySpec {
 	if x != nil {
-		return x.PayloadEncoding
+		return x.ColumnFamily
 	}
-	return PayloadEncoding_UNKNOWN_ENCODING
+	return nil
 }
 
-func (m *UpdateRequest) GetDocument() isUpdateRequest_Document {
-	if m != nil {
-		return m.Document
-	}
-	return nil
+type AddColumnFamilyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if the family was added successfully
+	// `TABLE_NOT_FOUND` - if the table does not exist
+	// `INVALID_ARGUMENT` - if the family with the same name or path already exists
+	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *UpdateRequest) GetJsonDocument() string {
-	if x, ok := x.GetDocument().(*UpdateRequest_JsonDocument); ok {
-		return x.JsonDocument
+func (x *AddColumnFamilyResponse) Reset() {
+	*x = AddColumnFamilyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (m *UpdateRequest) GetCondition() isUpdateRequest_Condition {
-	if m != nil {
-		return m.Condition
-	}
-	return nil
+func (x *AddColumnFamilyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateRequest) GetJsonCondition() string {
-	if x, ok := x.GetCondition().(*UpdateRequest_JsonCondition); ok {
-		return x.JsonCondition
+func (*AddColumnFamilyResponse) ProtoMessage() {}
+
+func (x *AddColumnFamilyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (m *UpdateRequest) GetMutation() isUpdateRequest_Mutation {
-	if m != nil {
-		return m.Mutation
-	}
-	return nil
+// Deprecated: Use AddColumnFamilyResponse.ProtoReflect.Descriptor instead.
+func (*AddColumnFamilyResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateRequest) GetJsonMutation() string {
-	if x, ok := x.GetMutation().(*UpdateRequest_JsonMutation); ok {
-		return x.JsonMutation
+func (x *AddColumnFamilyResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-type isUpdateRequest_Document interface {
-	isUpdateRequest_Document()
-}
+type AlterColumnFamilyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type UpdateRequest_JsonDocument struct {
+	TablePath string `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
 	//*
-	// <b>[Required]</b><p/>
-	// Contains JSON encoded OJAI Document with `_id` field when payload_encoding is `JSON_ENCODING`.<p/>
-	JsonDocument string `protobuf:"bytes,3,opt,name=json_document,json=jsonDocument,proto3,oneof"`
+	// New parameters of the family with the same name, `json_path` can't be changed
+	ColumnFamily *ColumnFamilySpec `protobuf:"bytes,2,opt,name=column_family,json=columnFamily,proto3" json:"column_family,omitempty"`
 }
 
-func (*UpdateRequest_JsonDocument) isUpdateRequest_Document() {}
-
-type isUpdateRequest_Condition interface {
-	isUpdateRequest_Condition()
+func (x *AlterColumnFamilyRequest) Reset() {
+	*x = AlterColumnFamilyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type UpdateRequest_JsonCondition struct {
-	//*
-	// <b>[Optional]</b><p/>
-	// Contains JSON encoded OJAI QueryCondition when payload_encoding is `JSON_ENCODING`.<p/>
-	JsonCondition string `protobuf:"bytes,4,opt,name=json_condition,json=jsonCondition,proto3,oneof"`
+func (x *AlterColumnFamilyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRequest_JsonCondition) isUpdateRequest_Condition() {}
+func (*AlterColumnFamilyRequest) ProtoMessage() {}
 
-type isUpdateRequest_Mutation interface {
-	isUpdateRequest_Mutation()
+func (x *AlterColumnFamilyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type UpdateRequest_JsonMutation struct {
-	//*
-	// <b>[Required]</b><p/>
-	// Contains JSON encoded OJAI DocumentMutation when payload_encoding is `JSON_ENCODING`.<p/>
-	JsonMutation string `protobuf:"bytes,30,opt,name=json_mutation,json=jsonMutation,proto3,oneof"`
+// Deprecated: Use AlterColumnFamilyRequest.ProtoReflect.Descriptor instead.
+func (*AlterColumnFamilyRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{21}
 }
 
-func (*UpdateRequest_JsonMutation) isUpdateRequest_Mutation() {}
+func (x *AlterColumnFamilyRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
 
-type UpdateResponse struct {
+func (x *AlterColumnFamilyRequest) GetColumnFamily() *ColumnFamilySpec {
+	if x != nil {
+		return x.ColumnFamily
+	}
+	return nil
+}
+
+type AlterColumnFamilyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//*
-	// `NO_ERROR` - if a document was updated successfully
-	// `DOCUMENT_NOT_FOUND` - if a document with specified `_id` does not exist or the specified condition
-	//                        evaluated to 'false'.
+	// `NO_ERROR` - if the family was altered successfully
+	// `TABLE_NOT_FOUND` - if the table does not exist
+	// `INVALID_ARGUMENT` - if the family does not exist
 	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *UpdateResponse) Reset() {
-	*x = UpdateResponse{}
+func (x *AlterColumnFamilyResponse) Reset() {
+	*x = AlterColumnFamilyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_maprdb_server_proto_msgTypes[16]
+		mi := &file_maprdb_server_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateResponse) String() string {
+func (x *AlterColumnFamilyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResponse) ProtoMessage() {}
+func (*AlterColumnFamilyResponse) ProtoMessage() {}
 
-func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_maprdb_server_proto_msgTypes[16]
+func (x *AlterColumnFamilyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,50 +1587,44 @@ func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
-func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return file_maprdb_server_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AlterColumnFamilyResponse.ProtoReflect.Descriptor instead.
+func (*AlterColumnFamilyResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateResponse) GetError() *RpcError {
+func (x *AlterColumnFamilyResponse) GetError() *RpcError {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type DeleteRequest struct {
+type DeleteColumnFamilyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TablePath       string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
-	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
-	// Types that are assignable to Condition:
-	//	*DeleteRequest_JsonCondition
-	Condition isDeleteRequest_Condition `protobuf_oneof:"condition"`
-	// Types that are assignable to Document:
-	//	*DeleteRequest_JsonDocument
-	Document isDeleteRequest_Document `protobuf_oneof:"document"`
+	TablePath string `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *DeleteRequest) Reset() {
-	*x = DeleteRequest{}
+func (x *DeleteColumnFamilyRequest) Reset() {
+	*x = DeleteColumnFamilyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_maprdb_server_proto_msgTypes[17]
+		mi := &file_maprdb_server_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteRequest) String() string {
+func (x *DeleteColumnFamilyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRequest) ProtoMessage() {}
+func (*DeleteColumnFamilyRequest) ProtoMessage() {}
 
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_maprdb_server_proto_msgTypes[17]
+func (x *DeleteColumnFamilyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1562,477 +1635,2634 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_maprdb_server_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DeleteColumnFamilyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteColumnFamilyRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteRequest) GetTablePath() string {
+func (x *DeleteColumnFamilyRequest) GetTablePath() string {
 	if x != nil {
 		return x.TablePath
 	}
 	return ""
 }
 
-func (x *DeleteRequest) GetPayloadEncoding() PayloadEncoding {
+func (x *DeleteColumnFamilyRequest) GetName() string {
 	if x != nil {
-		return x.PayloadEncoding
+		return x.Name
 	}
-	return PayloadEncoding_UNKNOWN_ENCODING
+	return ""
 }
 
-func (m *DeleteRequest) GetCondition() isDeleteRequest_Condition {
-	if m != nil {
-		return m.Condition
+type DeleteColumnFamilyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if the family was deleted successfully
+	// `TABLE_NOT_FOUND` - if the table does not exist
+	// `INVALID_ARGUMENT` - if the family does not exist or it is the default family
+	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DeleteColumnFamilyResponse) Reset() {
+	*x = DeleteColumnFamilyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *DeleteRequest) GetJsonCondition() string {
-	if x, ok := x.GetCondition().(*DeleteRequest_JsonCondition); ok {
-		return x.JsonCondition
+func (x *DeleteColumnFamilyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteColumnFamilyResponse) ProtoMessage() {}
+
+func (x *DeleteColumnFamilyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (m *DeleteRequest) GetDocument() isDeleteRequest_Document {
-	if m != nil {
-		return m.Document
+// Deprecated: Use DeleteColumnFamilyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteColumnFamilyResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteColumnFamilyResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
 	}
 	return nil
 }
 
-func (x *DeleteRequest) GetJsonDocument() string {
-	if x, ok := x.GetDocument().(*DeleteRequest_JsonDocument); ok {
-		return x.JsonDocument
-	}
-	return ""
+// *
+// Indexed field of secondary index
+type IndexFieldSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// OJAI FieldPath of the indexed field
+	FieldPath string `protobuf:"bytes,1,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"`
+	//*
+	// Whether the field is sorted in descending order
+	Descending bool `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
 }
 
-type isDeleteRequest_Condition interface {
-	isDeleteRequest_Condition()
+func (x *IndexFieldSpec) Reset() {
+	*x = IndexFieldSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type DeleteRequest_JsonCondition struct {
-	//*
-	// <b>[Optional]</b><p/>
-	// Contains JSON encoded OJAI QueryCondition when payload_encoding is `JSON_ENCODING`.<p/>
-	JsonCondition string `protobuf:"bytes,3,opt,name=json_condition,json=jsonCondition,proto3,oneof"`
+func (x *IndexFieldSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRequest_JsonCondition) isDeleteRequest_Condition() {}
+func (*IndexFieldSpec) ProtoMessage() {}
 
-type isDeleteRequest_Document interface {
-	isDeleteRequest_Document()
+func (x *IndexFieldSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type DeleteRequest_JsonDocument struct {
-	//*
-	// <b>[Required]</b><p/>
-	// Contains JSON encoded OJAI Document with `_id` field when payload_encoding is `JSON_ENCODING`.<p/>
-	JsonDocument string `protobuf:"bytes,4,opt,name=json_document,json=jsonDocument,proto3,oneof"`
+// Deprecated: Use IndexFieldSpec.ProtoReflect.Descriptor instead.
+func (*IndexFieldSpec) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{25}
 }
 
-func (*DeleteRequest_JsonDocument) isDeleteRequest_Document() {}
+func (x *IndexFieldSpec) GetFieldPath() string {
+	if x != nil {
+		return x.FieldPath
+	}
+	return ""
+}
 
-type DeleteResponse struct {
+func (x *IndexFieldSpec) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+// *
+// Parameters of secondary index of JSON table
+type IndexSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	//*
-	// `NO_ERROR` - if a document was deleted successfully
-	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// Indexed fields in the order of the index key
+	Fields []*IndexFieldSpec `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	//*
+	// OJAI FieldPaths of fields which are stored in the index to avoid lookups in the table
+	IncludedFields []string `protobuf:"bytes,3,rep,name=included_fields,json=includedFields,proto3" json:"included_fields,omitempty"`
+	//*
+	// Whether the index key is hashed to distribute writes between regions
+	Hashed bool `protobuf:"varint,4,opt,name=hashed,proto3" json:"hashed,omitempty"`
 }
 
-func (x *DeleteResponse) Reset() {
-	*x = DeleteResponse{}
+func (x *IndexSpec) Reset() {
+	*x = IndexSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_maprdb_server_proto_msgTypes[18]
+		mi := &file_maprdb_server_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteResponse) String() string {
+func (x *IndexSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResponse) ProtoMessage() {}
+func (*IndexSpec) ProtoMessage() {}
 
-func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_maprdb_server_proto_msgTypes[18]
+func (x *IndexSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_maprdb_server_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *DeleteResponse) GetError() *RpcError {
-	if x != nil {
-		return x.Error
-	}
-	return nil
-}
-
-var File_maprdb_server_proto protoreflect.FileDescriptor
-
-var file_maprdb_server_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x6d, 0x61, 0x70, 0x72, 0x64, 0x62, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x22, 0x91, 0x01, 0x0a, 0x08, 0x52, 0x70, 0x63, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70,
-	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x28, 0x0a, 0x10, 0x6a, 0x61, 0x76, 0x61, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6a, 0x61, 0x76,
-	0x61, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x50,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22,
-	0x47, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x47, 0x0a,
-	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x12, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x47, 0x0a, 0x13, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0xa9, 0x02, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4f,
-	0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3d,
-	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4d, 0x6f, 0x64,
-	0x65, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4c, 0x0a,
-	0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
-	0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0e, 0x6a,
-	0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6a,
-	0x73, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x63,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x4b, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x52, 0x65, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70,
-	0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89, 0x02,
-	0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x27, 0x0a, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f,
-	0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x42, 0x0b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a,
-	0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x10, 0x46, 0x69,
-	0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62,
-	0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25,
-	0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x1e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd1, 0x01,
-	0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x10,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70,
-	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0a, 0x6a, 0x73, 0x6f, 0x6e,
-	0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
-	0x6a, 0x73, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xf5, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64,
-	0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69,
-	0x6e, 0x67, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73,
-	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x98, 0x02, 0x0a, 0x0d, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e,
-	0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x27, 0x0a, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e,
-	0x5f, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x02, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x0a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x63,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x75, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe5, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
-	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0x42, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x2a, 0x85, 0x03, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00,
-	0x12, 0x13, 0x0a, 0x0f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
-	0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4f, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x4d, 0x45,
-	0x4d, 0x4f, 0x52, 0x59, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
-	0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x41, 0x42,
-	0x4c, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54,
-	0x53, 0x10, 0x11, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41,
-	0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x16, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x53,
-	0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x26, 0x12, 0x12, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x80, 0x02, 0x12, 0x1d, 0x0a, 0x18, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x45, 0x4e, 0x43, 0x4f,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x84, 0x02, 0x12, 0x16, 0x0a, 0x11, 0x43, 0x4c, 0x55, 0x53, 0x54,
-	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x8e, 0x02, 0x12,
-	0x13, 0x0a, 0x0e, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x8f, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54,
-	0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10,
-	0x98, 0x02, 0x12, 0x17, 0x0a, 0x12, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x99, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x45,
-	0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa2, 0x02,
-	0x12, 0x13, 0x0a, 0x0e, 0x44, 0x45, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0xa3, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c,
-	0x5f, 0x4d, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xa4, 0x02, 0x2a, 0x3a, 0x0a, 0x0f,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
-	0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44,
-	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x4e,
-	0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x4e, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x65,
-	0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x53, 0x45,
-	0x52, 0x54, 0x5f, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12,
-	0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52,
-	0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x03, 0x2a, 0x49, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x13,
-	0x0a, 0x0f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e,
-	0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x4c, 0x41,
-	0x4e, 0x10, 0x02, 0x32, 0x99, 0x06, 0x0a, 0x0c, 0x4d, 0x61, 0x70, 0x52, 0x44, 0x62, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e,
-	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a,
-	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x64, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d,
-	0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64,
-	0x62, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x65, 0x72,
-	0x74, 0x4f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4f, 0x72,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x53, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x12, 0x21, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x04, 0x46, 0x69, 0x6e, 0x64, 0x12, 0x1d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64,
-	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x4d, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4d, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x52, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x6d, 0x61, 0x70,
-	0x72, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x70, 0x72, 0x64, 0x62,
-	0x2d, 0x67, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x3b, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x72, 0x64, 0x62, 0x5f, 0x67, 0x6f, 0x5f, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_maprdb_server_proto_rawDescOnce sync.Once
-	file_maprdb_server_proto_rawDescData = file_maprdb_server_proto_rawDesc
-)
-
-func file_maprdb_server_proto_rawDescGZIP() []byte {
-	file_maprdb_server_proto_rawDescOnce.Do(func() {
-		file_maprdb_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_maprdb_server_proto_rawDescData)
-	})
-	return file_maprdb_server_proto_rawDescData
-}
-
-var file_maprdb_server_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_maprdb_server_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_maprdb_server_proto_goTypes = []interface{}{
-	(ErrorCode)(0),                  // 0: com.mapr.data.db.ErrorCode
-	(PayloadEncoding)(0),            // 1: com.mapr.data.db.PayloadEncoding
-	(InsertMode)(0),                 // 2: com.mapr.data.db.InsertMode
-	(FindResponseType)(0),           // 3: com.mapr.data.db.FindResponseType
-	(*RpcError)(nil),                // 4: com.mapr.data.db.RpcError
-	(*PingRequest)(nil),             // 5: com.mapr.data.db.PingRequest
-	(*PingResponse)(nil),            // 6: com.mapr.data.db.PingResponse
-	(*CreateTableRequest)(nil),      // 7: com.mapr.data.db.CreateTableRequest
-	(*CreateTableResponse)(nil),     // 8: com.mapr.data.db.CreateTableResponse
-	(*DeleteTableRequest)(nil),      // 9: com.mapr.data.db.DeleteTableRequest
-	(*DeleteTableResponse)(nil),     // 10: com.mapr.data.db.DeleteTableResponse
-	(*TableExistsRequest)(nil),      // 11: com.mapr.data.db.TableExistsRequest
-	(*TableExistsResponse)(nil),     // 12: com.mapr.data.db.TableExistsResponse
-	(*InsertOrReplaceRequest)(nil),  // 13: com.mapr.data.db.InsertOrReplaceRequest
-	(*InsertOrReplaceResponse)(nil), // 14: com.mapr.data.db.InsertOrReplaceResponse
-	(*FindByIdRequest)(nil),         // 15: com.mapr.data.db.FindByIdRequest
-	(*FindByIdResponse)(nil),        // 16: com.mapr.data.db.FindByIdResponse
-	(*FindRequest)(nil),             // 17: com.mapr.data.db.FindRequest
-	(*FindResponse)(nil),            // 18: com.mapr.data.db.FindResponse
-	(*UpdateRequest)(nil),           // 19: com.mapr.data.db.UpdateRequest
-	(*UpdateResponse)(nil),          // 20: com.mapr.data.db.UpdateResponse
-	(*DeleteRequest)(nil),           // 21: com.mapr.data.db.DeleteRequest
-	(*DeleteResponse)(nil),          // 22: com.mapr.data.db.DeleteResponse
-}
-var file_maprdb_server_proto_depIdxs = []int32{
-	0,  // 0: com.mapr.data.db.RpcError.err_code:type_name -> com.mapr.data.db.ErrorCode
-	4,  // 1: com.mapr.data.db.CreateTableResponse.error:type_name -> com.mapr.data.db.RpcError
-	4,  // 2: com.mapr.data.db.DeleteTableResponse.error:type_name -> com.mapr.data.db.RpcError
-	4,  // 3: com.mapr.data.db.TableExistsResponse.error:type_name -> com.mapr.data.db.RpcError
-	2,  // 4: com.mapr.data.db.InsertOrReplaceRequest.insert_mode:type_name -> com.mapr.data.db.InsertMode
-	1,  // 5: com.mapr.data.db.InsertOrReplaceRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
-	4,  // 6: com.mapr.data.db.InsertOrReplaceResponse.error:type_name -> com.mapr.data.db.RpcError
-	1,  // 7: com.mapr.data.db.FindByIdRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
-	4,  // 8: com.mapr.data.db.FindByIdResponse.error:type_name -> com.mapr.data.db.RpcError
-	1,  // 9: com.mapr.data.db.FindByIdResponse.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
-	1,  // 10: com.mapr.data.db.FindRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
-	4,  // 11: com.mapr.data.db.FindResponse.error:type_name -> com.mapr.data.db.RpcError
-	1,  // 12: com.mapr.data.db.FindResponse.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
-	3,  // 13: com.mapr.data.db.FindResponse.type:type_name -> com.mapr.data.db.FindResponseType
-	1,  // 14: com.mapr.data.db.UpdateRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
-	4,  // 15: com.mapr.data.db.UpdateResponse.error:type_name -> com.mapr.data.db.RpcError
-	1,  // 16: com.mapr.data.db.DeleteRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
-	4,  // 17: com.mapr.data.db.DeleteResponse.error:type_name -> com.mapr.data.db.RpcError
-	5,  // 18: com.mapr.data.db.MapRDbServer.Ping:input_type -> com.mapr.data.db.PingRequest
-	7,  // 19: com.mapr.data.db.MapRDbServer.CreateTable:input_type -> com.mapr.data.db.CreateTableRequest
-	9,  // 20: com.mapr.data.db.MapRDbServer.DeleteTable:input_type -> com.mapr.data.db.DeleteTableRequest
-	11, // 21: com.mapr.data.db.MapRDbServer.TableExists:input_type -> com.mapr.data.db.TableExistsRequest
-	13, // 22: com.mapr.data.db.MapRDbServer.InsertOrReplace:input_type -> com.mapr.data.db.InsertOrReplaceRequest
-	15, // 23: com.mapr.data.db.MapRDbServer.FindById:input_type -> com.mapr.data.db.FindByIdRequest
-	17, // 24: com.mapr.data.db.MapRDbServer.Find:input_type -> com.mapr.data.db.FindRequest
-	19, // 25: com.mapr.data.db.MapRDbServer.Update:input_type -> com.mapr.data.db.UpdateRequest
-	21, // 26: com.mapr.data.db.MapRDbServer.Delete:input_type -> com.mapr.data.db.DeleteRequest
-	6,  // 27: com.mapr.data.db.MapRDbServer.Ping:output_type -> com.mapr.data.db.PingResponse
-	8,  // 28: com.mapr.data.db.MapRDbServer.CreateTable:output_type -> com.mapr.data.db.CreateTableResponse
-	10, // 29: com.mapr.data.db.MapRDbServer.DeleteTable:output_type -> com.mapr.data.db.DeleteTableResponse
-	12, // 30: com.mapr.data.db.MapRDbServer.TableExists:output_type -> com.mapr.data.db.TableExistsResponse
-	14, // 31: com.mapr.data.db.MapRDbServer.InsertOrReplace:output_type -> com.mapr.data.db.InsertOrReplaceResponse
-	16, // 32: com.mapr.data.db.MapRDbServer.FindById:output_type -> com.mapr.data.db.FindByIdResponse
-	18, // 33: com.mapr.data.db.MapRDbServer.Find:output_type -> com.mapr.data.db.FindResponse
-	20, // 34: com.mapr.data.db.MapRDbServer.Update:output_type -> com.mapr.data.db.UpdateResponse
-	22, // 35: com.mapr.data.db.MapRDbServer.Delete:output_type -> com.mapr.data.db.DeleteResponse
-	27, // [27:36] is the sub-list for method output_type
-	18, // [18:27] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
-}
-
-func init() { file_maprdb_server_proto_init() }
-func file_maprdb_server_proto_init() {
-	if File_maprdb_server_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_maprdb_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RpcError); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexSpec.ProtoReflect.Descriptor instead.
+func (*IndexSpec) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *IndexSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IndexSpec) GetFields() []*IndexFieldSpec {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *IndexSpec) GetIncludedFields() []string {
+	if x != nil {
+		return x.IncludedFields
+	}
+	return nil
+}
+
+func (x *IndexSpec) GetHashed() bool {
+	if x != nil {
+		return x.Hashed
+	}
+	return false
+}
+
+type CreateIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath string     `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	IndexSpec *IndexSpec `protobuf:"bytes,2,opt,name=index_spec,json=indexSpec,proto3" json:"index_spec,omitempty"`
+}
+
+func (x *CreateIndexRequest) Reset() {
+	*x = CreateIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIndexRequest) ProtoMessage() {}
+
+func (x *CreateIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIndexRequest.ProtoReflect.Descriptor instead.
+func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateIndexRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
+
+func (x *CreateIndexRequest) GetIndexSpec() *IndexSpec {
+	if x != nil {
+		return x.IndexSpec
+	}
+	return nil
+}
+
+type CreateIndexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if the index was created successfully
+	// `TABLE_NOT_FOUND` - if the table does not exist
+	// `INVALID_ARGUMENT` - if the index with the same name already exists
+	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CreateIndexResponse) Reset() {
+	*x = CreateIndexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIndexResponse) ProtoMessage() {}
+
+func (x *CreateIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIndexResponse.ProtoReflect.Descriptor instead.
+func (*CreateIndexResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateIndexResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DropIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath string `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DropIndexRequest) Reset() {
+	*x = DropIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropIndexRequest) ProtoMessage() {}
+
+func (x *DropIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropIndexRequest.ProtoReflect.Descriptor instead.
+func (*DropIndexRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DropIndexRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
+
+func (x *DropIndexRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DropIndexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if the index was dropped successfully
+	// `TABLE_NOT_FOUND` - if the table does not exist
+	// `INVALID_ARGUMENT` - if the index does not exist
+	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DropIndexResponse) Reset() {
+	*x = DropIndexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropIndexResponse) ProtoMessage() {}
+
+func (x *DropIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropIndexResponse.ProtoReflect.Descriptor instead.
+func (*DropIndexResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DropIndexResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ListIndexesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath string `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+}
+
+func (x *ListIndexesRequest) Reset() {
+	*x = ListIndexesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIndexesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIndexesRequest) ProtoMessage() {}
+
+func (x *ListIndexesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIndexesRequest.ProtoReflect.Descriptor instead.
+func (*ListIndexesRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListIndexesRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
+
+type ListIndexesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if the table exists
+	// `TABLE_NOT_FOUND` - if the table does not exist
+	Error      *RpcError    `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	IndexSpecs []*IndexSpec `protobuf:"bytes,2,rep,name=index_specs,json=indexSpecs,proto3" json:"index_specs,omitempty"`
+}
+
+func (x *ListIndexesResponse) Reset() {
+	*x = ListIndexesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIndexesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIndexesResponse) ProtoMessage() {}
+
+func (x *ListIndexesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIndexesResponse.ProtoReflect.Descriptor instead.
+func (*ListIndexesResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListIndexesResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ListIndexesResponse) GetIndexSpecs() []*IndexSpec {
+	if x != nil {
+		return x.IndexSpecs
+	}
+	return nil
+}
+
+type InsertOrReplaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath       string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	InsertMode      InsertMode      `protobuf:"varint,2,opt,name=insert_mode,json=insertMode,proto3,enum=com.mapr.data.db.InsertMode" json:"insert_mode,omitempty"`
+	PayloadEncoding PayloadEncoding `protobuf:"varint,3,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
+	// Types that are assignable to Condition:
+	//	*InsertOrReplaceRequest_JsonCondition
+	Condition isInsertOrReplaceRequest_Condition `protobuf_oneof:"condition"`
+	// Types that are assignable to Data:
+	//	*InsertOrReplaceRequest_JsonDocument
+	Data isInsertOrReplaceRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *InsertOrReplaceRequest) Reset() {
+	*x = InsertOrReplaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertOrReplaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertOrReplaceRequest) ProtoMessage() {}
+
+func (x *InsertOrReplaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertOrReplaceRequest.ProtoReflect.Descriptor instead.
+func (*InsertOrReplaceRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InsertOrReplaceRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
+
+func (x *InsertOrReplaceRequest) GetInsertMode() InsertMode {
+	if x != nil {
+		return x.InsertMode
+	}
+	return InsertMode_UNKNOWN_MODE
+}
+
+func (x *InsertOrReplaceRequest) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_UNKNOWN_ENCODING
+}
+
+func (m *InsertOrReplaceRequest) GetCondition() isInsertOrReplaceRequest_Condition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (x *InsertOrReplaceRequest) GetJsonCondition() string {
+	if x, ok := x.GetCondition().(*InsertOrReplaceRequest_JsonCondition); ok {
+		return x.JsonCondition
+	}
+	return ""
+}
+
+func (m *InsertOrReplaceRequest) GetData() isInsertOrReplaceRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *InsertOrReplaceRequest) GetJsonDocument() string {
+	if x, ok := x.GetData().(*InsertOrReplaceRequest_JsonDocument); ok {
+		return x.JsonDocument
+	}
+	return ""
+}
+
+type isInsertOrReplaceRequest_Condition interface {
+	isInsertOrReplaceRequest_Condition()
+}
+
+type InsertOrReplaceRequest_JsonCondition struct {
+	//*
+	// <b>[Optional]</b><p/>
+	// Contains JSON encoded OJAI QueryCondition when payload_encoding is `JSON_ENCODING`.<p/>
+	// This should only be specified if the `insert_mode` == REPLACE
+	JsonCondition string `protobuf:"bytes,4,opt,name=json_condition,json=jsonCondition,proto3,oneof"`
+}
+
+func (*InsertOrReplaceRequest_JsonCondition) isInsertOrReplaceRequest_Condition() {}
+
+type isInsertOrReplaceRequest_Data interface {
+	isInsertOrReplaceRequest_Data()
+}
+
+type InsertOrReplaceRequest_JsonDocument struct {
+	//*
+	// <b>[Required]</b><p/>
+	// Contains JSON encoded OJAI Document if the payload_encoding is `JSON_ENCODING`
+	JsonDocument string `protobuf:"bytes,30,opt,name=json_document,json=jsonDocument,proto3,oneof"`
+}
+
+func (*InsertOrReplaceRequest_JsonDocument) isInsertOrReplaceRequest_Data() {}
+
+type InsertOrReplaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *InsertOrReplaceResponse) Reset() {
+	*x = InsertOrReplaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertOrReplaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertOrReplaceResponse) ProtoMessage() {}
+
+func (x *InsertOrReplaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertOrReplaceResponse.ProtoReflect.Descriptor instead.
+func (*InsertOrReplaceResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InsertOrReplaceResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type FindByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath       string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
+	//*
+	// <b>[Optional]</b><p/>
+	// List of OJAI FieldPaths that should be included in the returned document
+	Projections []string `protobuf:"bytes,3,rep,name=projections,proto3" json:"projections,omitempty"`
+	// Types that are assignable to Condition:
+	//	*FindByIdRequest_JsonCondition
+	Condition isFindByIdRequest_Condition `protobuf_oneof:"condition"`
+	// Types that are assignable to Document:
+	//	*FindByIdRequest_JsonDocument
+	Document isFindByIdRequest_Document `protobuf_oneof:"document"`
+}
+
+func (x *FindByIdRequest) Reset() {
+	*x = FindByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByIdRequest) ProtoMessage() {}
+
+func (x *FindByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByIdRequest.ProtoReflect.Descriptor instead.
+func (*FindByIdRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FindByIdRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
+
+func (x *FindByIdRequest) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_UNKNOWN_ENCODING
+}
+
+func (x *FindByIdRequest) GetProjections() []string {
+	if x != nil {
+		return x.Projections
+	}
+	return nil
+}
+
+func (m *FindByIdRequest) GetCondition() isFindByIdRequest_Condition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (x *FindByIdRequest) GetJsonCondition() string {
+	if x, ok := x.GetCondition().(*FindByIdRequest_JsonCondition); ok {
+		return x.JsonCondition
+	}
+	return ""
+}
+
+func (m *FindByIdRequest) GetDocument() isFindByIdRequest_Document {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (x *FindByIdRequest) GetJsonDocument() string {
+	if x, ok := x.GetDocument().(*FindByIdRequest_JsonDocument); ok {
+		return x.JsonDocument
+	}
+	return ""
+}
+
+type isFindByIdRequest_Condition interface {
+	isFindByIdRequest_Condition()
+}
+
+type FindByIdRequest_JsonCondition struct {
+	//*
+	// <b>[Optional]</b><p/>
+	// Contains JSON encoded OJAI QueryCondition when payload_encoding is `JSON_ENCODING`.<p/>
+	JsonCondition string `protobuf:"bytes,4,opt,name=json_condition,json=jsonCondition,proto3,oneof"`
+}
+
+func (*FindByIdRequest_JsonCondition) isFindByIdRequest_Condition() {}
+
+type isFindByIdRequest_Document interface {
+	isFindByIdRequest_Document()
+}
+
+type FindByIdRequest_JsonDocument struct {
+	//*
+	// <b>[Required]</b><p/>
+	// Contains JSON encoded OJAI Document with `_id` field when payload_encoding is `JSON_ENCODING`.<p/>
+	JsonDocument string `protobuf:"bytes,5,opt,name=json_document,json=jsonDocument,proto3,oneof"`
+}
+
+func (*FindByIdRequest_JsonDocument) isFindByIdRequest_Document() {}
+
+type FindByIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if a document with the specified `_id` was found
+	// `DOCUMENT_NOT_FOUND` - if the document with the specified `_id` does not exist
+	Error           *RpcError       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
+	// Types that are assignable to Data:
+	//	*FindByIdResponse_JsonDocument
+	Data isFindByIdResponse_Data `protobuf_oneof:"data"`
+}
+
+func (x *FindByIdResponse) Reset() {
+	*x = FindByIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByIdResponse) ProtoMessage() {}
+
+func (x *FindByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByIdResponse.ProtoReflect.Descriptor instead.
+func (*FindByIdResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FindByIdResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *FindByIdResponse) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_UNKNOWN_ENCODING
+}
+
+func (m *FindByIdResponse) GetData() isFindByIdResponse_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *FindByIdResponse) GetJsonDocument() string {
+	if x, ok := x.GetData().(*FindByIdResponse_JsonDocument); ok {
+		return x.JsonDocument
+	}
+	return ""
+}
+
+type isFindByIdResponse_Data interface {
+	isFindByIdResponse_Data()
+}
+
+type FindByIdResponse_JsonDocument struct {
+	//*
+	// <b>[Required]</b><p/>
+	// Contains JSON encoded OJAI Document if the payload_encoding is `JSON_ENCODING`
+	JsonDocument string `protobuf:"bytes,30,opt,name=json_document,json=jsonDocument,proto3,oneof"`
+}
+
+func (*FindByIdResponse_JsonDocument) isFindByIdResponse_Data() {}
+
+type FindRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath        string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	PayloadEncoding  PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
+	IncludeQueryPlan bool            `protobuf:"varint,3,opt,name=include_query_plan,json=includeQueryPlan,proto3" json:"include_query_plan,omitempty"`
+	// Types that are assignable to Data:
+	//	*FindRequest_JsonQuery
+	Data isFindRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *FindRequest) Reset() {
+	*x = FindRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRequest) ProtoMessage() {}
+
+func (x *FindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRequest.ProtoReflect.Descriptor instead.
+func (*FindRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FindRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
+
+func (x *FindRequest) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_UNKNOWN_ENCODING
+}
+
+func (x *FindRequest) GetIncludeQueryPlan() bool {
+	if x != nil {
+		return x.IncludeQueryPlan
+	}
+	return false
+}
+
+func (m *FindRequest) GetData() isFindRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *FindRequest) GetJsonQuery() string {
+	if x, ok := x.GetData().(*FindRequest_JsonQuery); ok {
+		return x.JsonQuery
+	}
+	return ""
+}
+
+type isFindRequest_Data interface {
+	isFindRequest_Data()
+}
+
+type FindRequest_JsonQuery struct {
+	//*
+	// <b>[Required]</b><p/>
+	// Contains JSON encoded OJAI Query if the payload_encoding is `JSON_ENCODING`
+	JsonQuery string `protobuf:"bytes,4,opt,name=json_query,json=jsonQuery,proto3,oneof"`
+}
+
+func (*FindRequest_JsonQuery) isFindRequest_Data() {}
+
+// *
+// Results of Find() RPCs are streamed to the clients, with each FindResponse containing
+// one OJAI document. If the `include_query_plan` in FindRequest was set to true, the first
+// FindResponse will contain the query plan instead of OJAI Document
+type FindResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error           *RpcError       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
+	//*
+	// Indicates the type of this response
+	Type FindResponseType `protobuf:"varint,3,opt,name=type,proto3,enum=com.mapr.data.db.FindResponseType" json:"type,omitempty"`
+	// Types that are assignable to Data:
+	//	*FindResponse_JsonResponse
+	Data isFindResponse_Data `protobuf_oneof:"data"`
+}
+
+func (x *FindResponse) Reset() {
+	*x = FindResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindResponse) ProtoMessage() {}
+
+func (x *FindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindResponse.ProtoReflect.Descriptor instead.
+func (*FindResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FindResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *FindResponse) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_UNKNOWN_ENCODING
+}
+
+func (x *FindResponse) GetType() FindResponseType {
+	if x != nil {
+		return x.Type
+	}
+	return FindResponseType_UNKNOWN_TYPE
+}
+
+func (m *FindResponse) GetData() isFindResponse_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *FindResponse) GetJsonResponse() string {
+	if x, ok := x.GetData().(*FindResponse_JsonResponse); ok {
+		return x.JsonResponse
+	}
+	return ""
+}
+
+type isFindResponse_Data interface {
+	isFindResponse_Data()
+}
+
+type FindResponse_JsonResponse struct {
+	//*
+	// Contains JSON encoded response if the payload_encoding is `JSON_ENCODING`
+	JsonResponse string `protobuf:"bytes,30,opt,name=json_response,json=jsonResponse,proto3,oneof"`
+}
+
+func (*FindResponse_JsonResponse) isFindResponse_Data() {}
+
+type UpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath       string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
+	// Types that are assignable to Document:
+	//	*UpdateRequest_JsonDocument
+	Document isUpdateRequest_Document `protobuf_oneof:"document"`
+	// Types that are assignable to Condition:
+	//	*UpdateRequest_JsonCondition
+	Condition isUpdateRequest_Condition `protobuf_oneof:"condition"`
+	// Types that are assignable to Mutation:
+	//	*UpdateRequest_JsonMutation
+	Mutation isUpdateRequest_Mutation `protobuf_oneof:"mutation"`
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_UNKNOWN_ENCODING
+}
+
+func (m *UpdateRequest) GetDocument() isUpdateRequest_Document {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetJsonDocument() string {
+	if x, ok := x.GetDocument().(*UpdateRequest_JsonDocument); ok {
+		return x.JsonDocument
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetCondition() isUpdateRequest_Condition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetJsonCondition() string {
+	if x, ok := x.GetCondition().(*UpdateRequest_JsonCondition); ok {
+		return x.JsonCondition
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetMutation() isUpdateRequest_Mutation {
+	if m != nil {
+		return m.Mutation
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetJsonMutation() string {
+	if x, ok := x.GetMutation().(*UpdateRequest_JsonMutation); ok {
+		return x.JsonMutation
+	}
+	return ""
+}
+
+type isUpdateRequest_Document interface {
+	isUpdateRequest_Document()
+}
+
+type UpdateRequest_JsonDocument struct {
+	//*
+	// <b>[Required]</b><p/>
+	// Contains JSON encoded OJAI Document with `_id` field when payload_encoding is `JSON_ENCODING`.<p/>
+	JsonDocument string `protobuf:"bytes,3,opt,name=json_document,json=jsonDocument,proto3,oneof"`
+}
+
+func (*UpdateRequest_JsonDocument) isUpdateRequest_Document() {}
+
+type isUpdateRequest_Condition interface {
+	isUpdateRequest_Condition()
+}
+
+type UpdateRequest_JsonCondition struct {
+	//*
+	// <b>[Optional]</b><p/>
+	// Contains JSON encoded OJAI QueryCondition when payload_encoding is `JSON_ENCODING`.<p/>
+	JsonCondition string `protobuf:"bytes,4,opt,name=json_condition,json=jsonCondition,proto3,oneof"`
+}
+
+func (*UpdateRequest_JsonCondition) isUpdateRequest_Condition() {}
+
+type isUpdateRequest_Mutation interface {
+	isUpdateRequest_Mutation()
+}
+
+type UpdateRequest_JsonMutation struct {
+	//*
+	// <b>[Required]</b><p/>
+	// Contains JSON encoded OJAI DocumentMutation when payload_encoding is `JSON_ENCODING`.<p/>
+	JsonMutation string `protobuf:"bytes,30,opt,name=json_mutation,json=jsonMutation,proto3,oneof"`
+}
+
+func (*UpdateRequest_JsonMutation) isUpdateRequest_Mutation() {}
+
+type UpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if a document was updated successfully
+	// `DOCUMENT_NOT_FOUND` - if a document with specified `_id` does not exist or the specified condition
+	//                        evaluated to 'false'.
+	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath       string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
+	// Types that are assignable to Condition:
+	//	*DeleteRequest_JsonCondition
+	Condition isDeleteRequest_Condition `protobuf_oneof:"condition"`
+	// Types that are assignable to Document:
+	//	*DeleteRequest_JsonDocument
+	Document isDeleteRequest_Document `protobuf_oneof:"document"`
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
+
+func (x *DeleteRequest) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_UNKNOWN_ENCODING
+}
+
+func (m *DeleteRequest) GetCondition() isDeleteRequest_Condition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (x *DeleteRequest) GetJsonCondition() string {
+	if x, ok := x.GetCondition().(*DeleteRequest_JsonCondition); ok {
+		return x.JsonCondition
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetDocument() isDeleteRequest_Document {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (x *DeleteRequest) GetJsonDocument() string {
+	if x, ok := x.GetDocument().(*DeleteRequest_JsonDocument); ok {
+		return x.JsonDocument
+	}
+	return ""
+}
+
+type isDeleteRequest_Condition interface {
+	isDeleteRequest_Condition()
+}
+
+type DeleteRequest_JsonCondition struct {
+	//*
+	// <b>[Optional]</b><p/>
+	// Contains JSON encoded OJAI QueryCondition when payload_encoding is `JSON_ENCODING`.<p/>
+	JsonCondition string `protobuf:"bytes,3,opt,name=json_condition,json=jsonCondition,proto3,oneof"`
+}
+
+func (*DeleteRequest_JsonCondition) isDeleteRequest_Condition() {}
+
+type isDeleteRequest_Document interface {
+	isDeleteRequest_Document()
+}
+
+type DeleteRequest_JsonDocument struct {
+	//*
+	// <b>[Required]</b><p/>
+	// Contains JSON encoded OJAI Document with `_id` field when payload_encoding is `JSON_ENCODING`.<p/>
+	JsonDocument string `protobuf:"bytes,4,opt,name=json_document,json=jsonDocument,proto3,oneof"`
+}
+
+func (*DeleteRequest_JsonDocument) isDeleteRequest_Document() {}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	// `NO_ERROR` - if a document was deleted successfully
+	Error *RpcError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TablePath       string          `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty"`
+	PayloadEncoding PayloadEncoding `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
+	//*
+	// <b>[Optional]</b><p/>
+	// Path and topic of the changelog stream, e.g. `/apps/changelog:users`.
+	// The first changelog of the table is used if not specified
+	Changelog string `protobuf:"bytes,3,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	//*
+	// <b>[Optional]</b><p/>
+	// Resume token of the last processed change, the changes after it are streamed.
+	// The changes made after the request are streamed if not specified
+	ResumeToken string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	//*
+	// <b>[Optional]</b><p/>
+	// List of OJAI FieldPaths, only the changes of these fields are streamed
+	FieldPaths []string `protobuf:"bytes,5,rep,name=field_paths,json=fieldPaths,proto3" json:"field_paths,omitempty"`
+	//*
+	// Whether the documents before and after the change should be included into responses
+	IncludeDocuments bool `protobuf:"varint,6,opt,name=include_documents,json=includeDocuments,proto3" json:"include_documents,omitempty"`
+}
+
+func (x *ChangesRequest) Reset() {
+	*x = ChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangesRequest) ProtoMessage() {}
+
+func (x *ChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangesRequest.ProtoReflect.Descriptor instead.
+func (*ChangesRequest) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ChangesRequest) GetTablePath() string {
+	if x != nil {
+		return x.TablePath
+	}
+	return ""
+}
+
+func (x *ChangesRequest) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_UNKNOWN_ENCODING
+}
+
+func (x *ChangesRequest) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+func (x *ChangesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ChangesRequest) GetFieldPaths() []string {
+	if x != nil {
+		return x.FieldPaths
+	}
+	return nil
+}
+
+func (x *ChangesRequest) GetIncludeDocuments() bool {
+	if x != nil {
+		return x.IncludeDocuments
+	}
+	return false
+}
+
+// *
+// Results of Changes() RPC are streamed to the clients until the request is cancelled,
+// each ChangesResponse contains one change record of the changelog
+type ChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error           *RpcError        `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	PayloadEncoding PayloadEncoding  `protobuf:"varint,2,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=com.mapr.data.db.PayloadEncoding" json:"payload_encoding,omitempty"`
+	Type            ChangeRecordType `protobuf:"varint,3,opt,name=type,proto3,enum=com.mapr.data.db.ChangeRecordType" json:"type,omitempty"`
+	//*
+	// Opaque position of the change in the changelog which can be used to resume the stream
+	ResumeToken string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	//*
+	// Time of the change in milliseconds since epoch
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	//*
+	// OJAI FieldPaths of the fields changed by the update
+	ChangedFieldPaths []string `protobuf:"bytes,6,rep,name=changed_field_paths,json=changedFieldPaths,proto3" json:"changed_field_paths,omitempty"`
+	// Types that are assignable to Id:
+	//	*ChangesResponse_JsonId
+	Id isChangesResponse_Id `protobuf_oneof:"id"`
+	// Types that are assignable to Before:
+	//	*ChangesResponse_JsonBefore
+	Before isChangesResponse_Before `protobuf_oneof:"before"`
+	// Types that are assignable to After:
+	//	*ChangesResponse_JsonAfter
+	After isChangesResponse_After `protobuf_oneof:"after"`
+}
+
+func (x *ChangesResponse) Reset() {
+	*x = ChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_maprdb_server_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangesResponse) ProtoMessage() {}
+
+func (x *ChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maprdb_server_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangesResponse.ProtoReflect.Descriptor instead.
+func (*ChangesResponse) Descriptor() ([]byte, []int) {
+	return file_maprdb_server_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChangesResponse) GetError() *RpcError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ChangesResponse) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_UNKNOWN_ENCODING
+}
+
+func (x *ChangesResponse) GetType() ChangeRecordType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeRecordType_UNKNOWN_CHANGE_TYPE
+}
+
+func (x *ChangesResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ChangesResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChangesResponse) GetChangedFieldPaths() []string {
+	if x != nil {
+		return x.ChangedFieldPaths
+	}
+	return nil
+}
+
+func (m *ChangesResponse) GetId() isChangesResponse_Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (x *ChangesResponse) GetJsonId() string {
+	if x, ok := x.GetId().(*ChangesResponse_JsonId); ok {
+		return x.JsonId
+	}
+	return ""
+}
+
+func (m *ChangesResponse) GetBefore() isChangesResponse_Before {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (x *ChangesResponse) GetJsonBefore() string {
+	if x, ok := x.GetBefore().(*ChangesResponse_JsonBefore); ok {
+		return x.JsonBefore
+	}
+	return ""
+}
+
+func (m *ChangesResponse) GetAfter() isChangesResponse_After {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (x *ChangesResponse) GetJsonAfter() string {
+	if x, ok := x.GetAfter().(*ChangesResponse_JsonAfter); ok {
+		return x.JsonAfter
+	}
+	return ""
+}
+
+type isChangesResponse_Id interface {
+	isChangesResponse_Id()
+}
+
+type ChangesResponse_JsonId struct {
+	//*
+	// Contains JSON encoded OJAI Document with `_id` field of the changed document
+	// when payload_encoding is `JSON_ENCODING`
+	JsonId string `protobuf:"bytes,7,opt,name=json_id,json=jsonId,proto3,oneof"`
+}
+
+func (*ChangesResponse_JsonId) isChangesResponse_Id() {}
+
+type isChangesResponse_Before interface {
+	isChangesResponse_Before()
+}
+
+type ChangesResponse_JsonBefore struct {
+	//*
+	// <b>[Optional]</b><p/>
+	// Contains JSON encoded OJAI Document before the change if it was requested and it is available
+	JsonBefore string `protobuf:"bytes,8,opt,name=json_before,json=jsonBefore,proto3,oneof"`
+}
+
+func (*ChangesResponse_JsonBefore) isChangesResponse_Before() {}
+
+type isChangesResponse_After interface {
+	isChangesResponse_After()
+}
+
+type ChangesResponse_JsonAfter struct {
+	//*
+	// <b>[Optional]</b><p/>
+	// Contains JSON encoded OJAI Document after the change if it was requested and it is available
+	JsonAfter string `protobuf:"bytes,9,opt,name=json_after,json=jsonAfter,proto3,oneof"`
+}
+
+func (*ChangesResponse_JsonAfter) isChangesResponse_After() {}
+
+var File_maprdb_server_proto protoreflect.FileDescriptor
+
+var file_maprdb_server_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x6d, 0x61, 0x70, 0x72, 0x64, 0x62, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x22, 0x91, 0x01, 0x0a, 0x08, 0x52, 0x70, 0x63, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x6a, 0x61, 0x76, 0x61, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6a, 0x61, 0x76,
+	0x61, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22,
+	0x47, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x47, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x12, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x47, 0x0a, 0x13, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x72, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0xd2, 0x01, 0x0a, 0x09, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x75, 0x6c, 0x6b, 0x4c, 0x6f, 0x61, 0x64,
+	0x12, 0x4b, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x22, 0x5e, 0x0a,
+	0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x22, 0x55, 0x0a,
+	0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x67, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x85, 0x01,
+	0x0a, 0x15, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62,
+	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x22, 0x4f, 0x0a, 0x11, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64,
+	0x62, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x22, 0x46, 0x0a, 0x12, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52,
+	0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x80,
+	0x01, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x47, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x22, 0x4b, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52,
+	0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82,
+	0x01, 0x0a, 0x18, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x47, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x22, 0x4d, 0x0a, 0x19, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x4e, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x4f, 0x0a, 0x0e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0x9a, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64,
+	0x22, 0x6f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3a, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x70, 0x65,
+	0x63, 0x22, 0x47, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
+	0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x10, 0x44, 0x72,
+	0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x45, 0x0a, 0x11, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x85, 0x01,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x53, 0x70, 0x65, 0x63, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x4f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x3d, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4c,
+	0x0a, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d,
+	0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0e,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c,
+	0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x4b, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52,
+	0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89,
+	0x02, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x27, 0x0a, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6a, 0x73, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a,
+	0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x10, 0x46,
+	0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64,
+	0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd1,
+	0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a,
+	0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
+	0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0a, 0x6a, 0x73, 0x6f,
+	0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xf5, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0d, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x98, 0x02, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x10, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f,
+	0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x75, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe5, 0x01, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x42, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8c, 0x02, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c,
+	0x6f, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x6c, 0x6f, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xb2, 0x03, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
+	0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x52, 0x70, 0x63, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x12, 0x19, 0x0a, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6a, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0b,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x1f, 0x0a, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x42, 0x04, 0x0a, 0x02, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x2a, 0x85, 0x03, 0x0a, 0x09, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4f,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x55, 0x54, 0x5f,
+	0x4f, 0x46, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x41,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x18,
+	0x0a, 0x14, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x11, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x16, 0x12, 0x19,
+	0x0a, 0x15, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x26, 0x12, 0x12, 0x0a, 0x0d, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x80, 0x02, 0x12, 0x1d, 0x0a,
+	0x18, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44,
+	0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x84, 0x02, 0x12, 0x16, 0x0a, 0x11,
+	0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x8e, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x8f, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x44, 0x4f, 0x43,
+	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58,
+	0x49, 0x53, 0x54, 0x53, 0x10, 0x98, 0x02, 0x12, 0x17, 0x0a, 0x12, 0x44, 0x4f, 0x43, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x99, 0x02,
+	0x12, 0x13, 0x0a, 0x0e, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0xa2, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x44, 0x45, 0x43, 0x4f, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa3, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x49, 0x4c,
+	0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f, 0x4d, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xa4,
+	0x02, 0x2a, 0x3a, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
+	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x53,
+	0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x4e, 0x0a,
+	0x0a, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41,
+	0x43, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x03, 0x2a, 0x49, 0x0a,
+	0x10, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x4f,
+	0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x51, 0x55, 0x45, 0x52,
+	0x59, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x10, 0x02, 0x2a, 0x64, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x32, 0xf1,
+	0x0e, 0x0a, 0x0c, 0x4d, 0x61, 0x70, 0x52, 0x44, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
+	0x47, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
+	0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
+	0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x86, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x12, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x64, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
+	0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x64, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x41, 0x6c,
+	0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d,
+	0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x65,
+	0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62,
+	0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d,
+	0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6e, 0x0a, 0x11, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x64, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x71, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
+	0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62,
+	0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
+	0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x4f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x4f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x53, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x12, 0x21, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x64, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x04, 0x46, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x4d, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x42, 0x52, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x70, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36,
+	0x6d, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x70,
+	0x72, 0x64, 0x62, 0x2d, 0x67, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x3b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x72, 0x64, 0x62, 0x5f, 0x67, 0x6f, 0x5f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_maprdb_server_proto_rawDescOnce sync.Once
+	file_maprdb_server_proto_rawDescData = file_maprdb_server_proto_rawDesc
+)
+
+func file_maprdb_server_proto_rawDescGZIP() []byte {
+	file_maprdb_server_proto_rawDescOnce.Do(func() {
+		file_maprdb_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_maprdb_server_proto_rawDescData)
+	})
+	return file_maprdb_server_proto_rawDescData
+}
+
+var file_maprdb_server_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_maprdb_server_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_maprdb_server_proto_goTypes = []interface{}{
+	(ErrorCode)(0),                            // 0: com.mapr.data.db.ErrorCode
+	(PayloadEncoding)(0),                      // 1: com.mapr.data.db.PayloadEncoding
+	(InsertMode)(0),                           // 2: com.mapr.data.db.InsertMode
+	(FindResponseType)(0),                     // 3: com.mapr.data.db.FindResponseType
+	(ChangeRecordType)(0),                     // 4: com.mapr.data.db.ChangeRecordType
+	(*RpcError)(nil),                          // 5: com.mapr.data.db.RpcError
+	(*PingRequest)(nil),                       // 6: com.mapr.data.db.PingRequest
+	(*PingResponse)(nil),                      // 7: com.mapr.data.db.PingResponse
+	(*CreateTableRequest)(nil),                // 8: com.mapr.data.db.CreateTableRequest
+	(*CreateTableResponse)(nil),               // 9: com.mapr.data.db.CreateTableResponse
+	(*DeleteTableRequest)(nil),                // 10: com.mapr.data.db.DeleteTableRequest
+	(*DeleteTableResponse)(nil),               // 11: com.mapr.data.db.DeleteTableResponse
+	(*TableExistsRequest)(nil),                // 12: com.mapr.data.db.TableExistsRequest
+	(*TableExistsResponse)(nil),               // 13: com.mapr.data.db.TableExistsResponse
+	(*ColumnFamilySpec)(nil),                  // 14: com.mapr.data.db.ColumnFamilySpec
+	(*TableSpec)(nil),                         // 15: com.mapr.data.db.TableSpec
+	(*CreateTableWithDescriptorRequest)(nil),  // 16: com.mapr.data.db.CreateTableWithDescriptorRequest
+	(*CreateTableWithDescriptorResponse)(nil), // 17: com.mapr.data.db.CreateTableWithDescriptorResponse
+	(*ListTablesRequest)(nil),                 // 18: com.mapr.data.db.ListTablesRequest
+	(*ListTablesResponse)(nil),                // 19: com.mapr.data.db.ListTablesResponse
+	(*DescribeTableRequest)(nil),              // 20: com.mapr.data.db.DescribeTableRequest
+	(*DescribeTableResponse)(nil),             // 21: com.mapr.data.db.DescribeTableResponse
+	(*AlterTableRequest)(nil),                 // 22: com.mapr.data.db.AlterTableRequest
+	(*AlterTableResponse)(nil),                // 23: com.mapr.data.db.AlterTableResponse
+	(*AddColumnFamilyRequest)(nil),            // 24: com.mapr.data.db.AddColumnFamilyRequest
+	(*AddColumnFamilyResponse)(nil),           // 25: com.mapr.data.db.AddColumnFamilyResponse
+	(*AlterColumnFamilyRequest)(nil),          // 26: com.mapr.data.db.AlterColumnFamilyRequest
+	(*AlterColumnFamilyResponse)(nil),         // 27: com.mapr.data.db.AlterColumnFamilyResponse
+	(*DeleteColumnFamilyRequest)(nil),         // 28: com.mapr.data.db.DeleteColumnFamilyRequest
+	(*DeleteColumnFamilyResponse)(nil),        // 29: com.mapr.data.db.DeleteColumnFamilyResponse
+	(*IndexFieldSpec)(nil),                    // 30: com.mapr.data.db.IndexFieldSpec
+	(*IndexSpec)(nil),                         // 31: com.mapr.data.db.IndexSpec
+	(*CreateIndexRequest)(nil),                // 32: com.mapr.data.db.CreateIndexRequest
+	(*CreateIndexResponse)(nil),               // 33: com.mapr.data.db.CreateIndexResponse
+	(*DropIndexRequest)(nil),                  // 34: com.mapr.data.db.DropIndexRequest
+	(*DropIndexResponse)(nil),                 // 35: com.mapr.data.db.DropIndexResponse
+	(*ListIndexesRequest)(nil),                // 36: com.mapr.data.db.ListIndexesRequest
+	(*ListIndexesResponse)(nil),               // 37: com.mapr.data.db.ListIndexesResponse
+	(*InsertOrReplaceRequest)(nil),            // 38: com.mapr.data.db.InsertOrReplaceRequest
+	(*InsertOrReplaceResponse)(nil),           // 39: com.mapr.data.db.InsertOrReplaceResponse
+	(*FindByIdRequest)(nil),                   // 40: com.mapr.data.db.FindByIdRequest
+	(*FindByIdResponse)(nil),                  // 41: com.mapr.data.db.FindByIdResponse
+	(*FindRequest)(nil),                       // 42: com.mapr.data.db.FindRequest
+	(*FindResponse)(nil),                      // 43: com.mapr.data.db.FindResponse
+	(*UpdateRequest)(nil),                     // 44: com.mapr.data.db.UpdateRequest
+	(*UpdateResponse)(nil),                    // 45: com.mapr.data.db.UpdateResponse
+	(*DeleteRequest)(nil),                     // 46: com.mapr.data.db.DeleteRequest
+	(*DeleteResponse)(nil),                    // 47: com.mapr.data.db.DeleteResponse
+	(*ChangesRequest)(nil),                    // 48: com.mapr.data.db.ChangesRequest
+	(*ChangesResponse)(nil),                   // 49: com.mapr.data.db.ChangesResponse
+}
+var file_maprdb_server_proto_depIdxs = []int32{
+	0,  // 0: com.mapr.data.db.RpcError.err_code:type_name -> com.mapr.data.db.ErrorCode
+	5,  // 1: com.mapr.data.db.CreateTableResponse.error:type_name -> com.mapr.data.db.RpcError
+	5,  // 2: com.mapr.data.db.DeleteTableResponse.error:type_name -> com.mapr.data.db.RpcError
+	5,  // 3: com.mapr.data.db.TableExistsResponse.error:type_name -> com.mapr.data.db.RpcError
+	14, // 4: com.mapr.data.db.TableSpec.column_families:type_name -> com.mapr.data.db.ColumnFamilySpec
+	15, // 5: com.mapr.data.db.CreateTableWithDescriptorRequest.table_spec:type_name -> com.mapr.data.db.TableSpec
+	5,  // 6: com.mapr.data.db.CreateTableWithDescriptorResponse.error:type_name -> com.mapr.data.db.RpcError
+	5,  // 7: com.mapr.data.db.ListTablesResponse.error:type_name -> com.mapr.data.db.RpcError
+	5,  // 8: com.mapr.data.db.DescribeTableResponse.error:type_name -> com.mapr.data.db.RpcError
+	15, // 9: com.mapr.data.db.DescribeTableResponse.table_spec:type_name -> com.mapr.data.db.TableSpec
+	15, // 10: com.mapr.data.db.AlterTableRequest.table_spec:type_name -> com.mapr.data.db.TableSpec
+	5,  // 11: com.mapr.data.db.AlterTableResponse.error:type_name -> com.mapr.data.db.RpcError
+	14, // 12: com.mapr.data.db.AddColumnFamilyRequest.column_family:type_name -> com.mapr.data.db.ColumnFamilySpec
+	5,  // 13: com.mapr.data.db.AddColumnFamilyResponse.error:type_name -> com.mapr.data.db.RpcError
+	14, // 14: com.mapr.data.db.AlterColumnFamilyRequest.column_family:type_name -> com.mapr.data.db.ColumnFamilySpec
+	5,  // 15: com.mapr.data.db.AlterColumnFamilyResponse.error:type_name -> com.mapr.data.db.RpcError
+	5,  // 16: com.mapr.data.db.DeleteColumnFamilyResponse.error:type_name -> com.mapr.data.db.RpcError
+	30, // 17: com.mapr.data.db.IndexSpec.fields:type_name -> com.mapr.data.db.IndexFieldSpec
+	31, // 18: com.mapr.data.db.CreateIndexRequest.index_spec:type_name -> com.mapr.data.db.IndexSpec
+	5,  // 19: com.mapr.data.db.CreateIndexResponse.error:type_name -> com.mapr.data.db.RpcError
+	5,  // 20: com.mapr.data.db.DropIndexResponse.error:type_name -> com.mapr.data.db.RpcError
+	5,  // 21: com.mapr.data.db.ListIndexesResponse.error:type_name -> com.mapr.data.db.RpcError
+	31, // 22: com.mapr.data.db.ListIndexesResponse.index_specs:type_name -> com.mapr.data.db.IndexSpec
+	2,  // 23: com.mapr.data.db.InsertOrReplaceRequest.insert_mode:type_name -> com.mapr.data.db.InsertMode
+	1,  // 24: com.mapr.data.db.InsertOrReplaceRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
+	5,  // 25: com.mapr.data.db.InsertOrReplaceResponse.error:type_name -> com.mapr.data.db.RpcError
+	1,  // 26: com.mapr.data.db.FindByIdRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
+	5,  // 27: com.mapr.data.db.FindByIdResponse.error:type_name -> com.mapr.data.db.RpcError
+	1,  // 28: com.mapr.data.db.FindByIdResponse.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
+	1,  // 29: com.mapr.data.db.FindRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
+	5,  // 30: com.mapr.data.db.FindResponse.error:type_name -> com.mapr.data.db.RpcError
+	1,  // 31: com.mapr.data.db.FindResponse.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
+	3,  // 32: com.mapr.data.db.FindResponse.type:type_name -> com.mapr.data.db.FindResponseType
+	1,  // 33: com.mapr.data.db.UpdateRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
+	5,  // 34: com.mapr.data.db.UpdateResponse.error:type_name -> com.mapr.data.db.RpcError
+	1,  // 35: com.mapr.data.db.DeleteRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
+	5,  // 36: com.mapr.data.db.DeleteResponse.error:type_name -> com.mapr.data.db.RpcError
+	1,  // 37: com.mapr.data.db.ChangesRequest.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
+	5,  // 38: com.mapr.data.db.ChangesResponse.error:type_name -> com.mapr.data.db.RpcError
+	1,  // 39: com.mapr.data.db.ChangesResponse.payload_encoding:type_name -> com.mapr.data.db.PayloadEncoding
+	4,  // 40: com.mapr.data.db.ChangesResponse.type:type_name -> com.mapr.data.db.ChangeRecordType
+	6,  // 41: com.mapr.data.db.MapRDbServer.Ping:input_type -> com.mapr.data.db.PingRequest
+	8,  // 42: com.mapr.data.db.MapRDbServer.CreateTable:input_type -> com.mapr.data.db.CreateTableRequest
+	10, // 43: com.mapr.data.db.MapRDbServer.DeleteTable:input_type -> com.mapr.data.db.DeleteTableRequest
+	12, // 44: com.mapr.data.db.MapRDbServer.TableExists:input_type -> com.mapr.data.db.TableExistsRequest
+	16, // 45: com.mapr.data.db.MapRDbServer.CreateTableWithDescriptor:input_type -> com.mapr.data.db.CreateTableWithDescriptorRequest
+	18, // 46: com.mapr.data.db.MapRDbServer.ListTables:input_type -> com.mapr.data.db.ListTablesRequest
+	20, // 47: com.mapr.data.db.MapRDbServer.DescribeTable:input_type -> com.mapr.data.db.DescribeTableRequest
+	22, // 48: com.mapr.data.db.MapRDbServer.AlterTable:input_type -> com.mapr.data.db.AlterTableRequest
+	24, // 49: com.mapr.data.db.MapRDbServer.AddColumnFamily:input_type -> com.mapr.data.db.AddColumnFamilyRequest
+	26, // 50: com.mapr.data.db.MapRDbServer.AlterColumnFamily:input_type -> com.mapr.data.db.AlterColumnFamilyRequest
+	28, // 51: com.mapr.data.db.MapRDbServer.DeleteColumnFamily:input_type -> com.mapr.data.db.DeleteColumnFamilyRequest
+	32, // 52: com.mapr.data.db.MapRDbServer.CreateIndex:input_type -> com.mapr.data.db.CreateIndexRequest
+	34, // 53: com.mapr.data.db.MapRDbServer.DropIndex:input_type -> com.mapr.data.db.DropIndexRequest
+	36, // 54: com.mapr.data.db.MapRDbServer.ListIndexes:input_type -> com.mapr.data.db.ListIndexesRequest
+	38, // 55: com.mapr.data.db.MapRDbServer.InsertOrReplace:input_type -> com.mapr.data.db.InsertOrReplaceRequest
+	40, // 56: com.mapr.data.db.MapRDbServer.FindById:input_type -> com.mapr.data.db.FindByIdRequest
+	42, // 57: com.mapr.data.db.MapRDbServer.Find:input_type -> com.mapr.data.db.FindRequest
+	44, // 58: com.mapr.data.db.MapRDbServer.Update:input_type -> com.mapr.data.db.UpdateRequest
+	46, // 59: com.mapr.data.db.MapRDbServer.Delete:input_type -> com.mapr.data.db.DeleteRequest
+	48, // 60: com.mapr.data.db.MapRDbServer.Changes:input_type -> com.mapr.data.db.ChangesRequest
+	7,  // 61: com.mapr.data.db.MapRDbServer.Ping:output_type -> com.mapr.data.db.PingResponse
+	9,  // 62: com.mapr.data.db.MapRDbServer.CreateTable:output_type -> com.mapr.data.db.CreateTableResponse
+	11, // 63: com.mapr.data.db.MapRDbServer.DeleteTable:output_type -> com.mapr.data.db.DeleteTableResponse
+	13, // 64: com.mapr.data.db.MapRDbServer.TableExists:output_type -> com.mapr.data.db.TableExistsResponse
+	17, // 65: com.mapr.data.db.MapRDbServer.CreateTableWithDescriptor:output_type -> com.mapr.data.db.CreateTableWithDescriptorResponse
+	19, // 66: com.mapr.data.db.MapRDbServer.ListTables:output_type -> com.mapr.data.db.ListTablesResponse
+	21, // 67: com.mapr.data.db.MapRDbServer.DescribeTable:output_type -> com.mapr.data.db.DescribeTableResponse
+	23, // 68: com.mapr.data.db.MapRDbServer.AlterTable:output_type -> com.mapr.data.db.AlterTableResponse
+	25, // 69: com.mapr.data.db.MapRDbServer.AddColumnFamily:output_type -> com.mapr.data.db.AddColumnFamilyResponse
+	27, // 70: com.mapr.data.db.MapRDbServer.AlterColumnFamily:output_type -> com.mapr.data.db.AlterColumnFamilyResponse
+	29, // 71: com.mapr.data.db.MapRDbServer.DeleteColumnFamily:output_type -> com.mapr.data.db.DeleteColumnFamilyResponse
+	33, // 72: com.mapr.data.db.MapRDbServer.CreateIndex:output_type -> com.mapr.data.db.CreateIndexResponse
+	35, // 73: com.mapr.data.db.MapRDbServer.DropIndex:output_type -> com.mapr.data.db.DropIndexResponse
+	37, // 74: com.mapr.data.db.MapRDbServer.ListIndexes:output_type -> com.mapr.data.db.ListIndexesResponse
+	39, // 75: com.mapr.data.db.MapRDbServer.InsertOrReplace:output_type -> com.mapr.data.db.InsertOrReplaceResponse
+	41, // 76: com.mapr.data.db.MapRDbServer.FindById:output_type -> com.mapr.data.db.FindByIdResponse
+	43, // 77: com.mapr.data.db.MapRDbServer.Find:output_type -> com.mapr.data.db.FindResponse
+	45, // 78: com.mapr.data.db.MapRDbServer.Update:output_type -> com.mapr.data.db.UpdateResponse
+	47, // 79: com.mapr.data.db.MapRDbServer.Delete:output_type -> com.mapr.data.db.DeleteResponse
+	49, // 80: com.mapr.data.db.MapRDbServer.Changes:output_type -> com.mapr.data.db.ChangesResponse
+	61, // [61:81] is the sub-list for method output_type
+	41, // [41:61] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
+}
+
+func init() { file_maprdb_server_proto_init() }
+func file_maprdb_server_proto_init() {
+	if File_maprdb_server_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_maprdb_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableExistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColumnFamilySpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTableWithDescriptorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTableWithDescriptorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTablesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTablesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeTableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2043,8 +4273,8 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRequest); i {
+		file_maprdb_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlterTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2055,8 +4285,8 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_maprdb_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlterTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2067,8 +4297,8 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTableRequest); i {
+		file_maprdb_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddColumnFamilyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2079,8 +4309,8 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTableResponse); i {
+		file_maprdb_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddColumnFamilyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2091,8 +4321,8 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTableRequest); i {
+		file_maprdb_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlterColumnFamilyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2103,8 +4333,8 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTableResponse); i {
+		file_maprdb_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlterColumnFamilyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2115,8 +4345,8 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TableExistsRequest); i {
+		file_maprdb_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteColumnFamilyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2127,8 +4357,8 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TableExistsResponse); i {
+		file_maprdb_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteColumnFamilyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2139,7 +4369,103 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexFieldSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIndexesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIndexesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InsertOrReplaceRequest); i {
 			case 0:
 				return &v.state
@@ -2151,7 +4477,7 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InsertOrReplaceResponse); i {
 			case 0:
 				return &v.state
@@ -2163,7 +4489,7 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindByIdRequest); i {
 			case 0:
 				return &v.state
@@ -2175,7 +4501,7 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindByIdResponse); i {
 			case 0:
 				return &v.state
@@ -2187,7 +4513,7 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindRequest); i {
 			case 0:
 				return &v.state
@@ -2199,7 +4525,7 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindResponse); i {
 			case 0:
 				return &v.state
@@ -2211,7 +4537,7 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateRequest); i {
 			case 0:
 				return &v.state
@@ -2223,7 +4549,7 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateResponse); i {
 			case 0:
 				return &v.state
@@ -2235,7 +4561,7 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteRequest); i {
 			case 0:
 				return &v.state
@@ -2247,7 +4573,7 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
-		file_maprdb_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_maprdb_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteResponse); i {
 			case 0:
 				return &v.state
@@ -2259,40 +4585,69 @@ func file_maprdb_server_proto_init() {
 				return nil
 			}
 		}
+		file_maprdb_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_maprdb_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_maprdb_server_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_maprdb_server_proto_msgTypes[33].OneofWrappers = []interface{}{
 		(*InsertOrReplaceRequest_JsonCondition)(nil),
 		(*InsertOrReplaceRequest_JsonDocument)(nil),
 	}
-	file_maprdb_server_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_maprdb_server_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*FindByIdRequest_JsonCondition)(nil),
 		(*FindByIdRequest_JsonDocument)(nil),
 	}
-	file_maprdb_server_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_maprdb_server_proto_msgTypes[36].OneofWrappers = []interface{}{
 		(*FindByIdResponse_JsonDocument)(nil),
 	}
-	file_maprdb_server_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_maprdb_server_proto_msgTypes[37].OneofWrappers = []interface{}{
 		(*FindRequest_JsonQuery)(nil),
 	}
-	file_maprdb_server_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_maprdb_server_proto_msgTypes[38].OneofWrappers = []interface{}{
 		(*FindResponse_JsonResponse)(nil),
 	}
-	file_maprdb_server_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_maprdb_server_proto_msgTypes[39].OneofWrappers = []interface{}{
 		(*UpdateRequest_JsonDocument)(nil),
 		(*UpdateRequest_JsonCondition)(nil),
 		(*UpdateRequest_JsonMutation)(nil),
 	}
-	file_maprdb_server_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_maprdb_server_proto_msgTypes[41].OneofWrappers = []interface{}{
 		(*DeleteRequest_JsonCondition)(nil),
 		(*DeleteRequest_JsonDocument)(nil),
 	}
+	file_maprdb_server_proto_msgTypes[44].OneofWrappers = []interface{}{
+		(*ChangesResponse_JsonId)(nil),
+		(*ChangesResponse_JsonBefore)(nil),
+		(*ChangesResponse_JsonAfter)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_maprdb_server_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   19,
+			NumEnums:      5,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
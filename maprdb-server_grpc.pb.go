@@ -1,4 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.14.0
+// source: maprdb-server.proto
 
 package private_maprdb_go_client
 
@@ -24,12 +28,24 @@ type MapRDbServerClient interface {
 	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*CreateTableResponse, error)
 	DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResponse, error)
 	TableExists(ctx context.Context, in *TableExistsRequest, opts ...grpc.CallOption) (*TableExistsResponse, error)
+	CreateTableWithDescriptor(ctx context.Context, in *CreateTableWithDescriptorRequest, opts ...grpc.CallOption) (*CreateTableWithDescriptorResponse, error)
+	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error)
+	DescribeTable(ctx context.Context, in *DescribeTableRequest, opts ...grpc.CallOption) (*DescribeTableResponse, error)
+	AlterTable(ctx context.Context, in *AlterTableRequest, opts ...grpc.CallOption) (*AlterTableResponse, error)
+	AddColumnFamily(ctx context.Context, in *AddColumnFamilyRequest, opts ...grpc.CallOption) (*AddColumnFamilyResponse, error)
+	AlterColumnFamily(ctx context.Context, in *AlterColumnFamilyRequest, opts ...grpc.CallOption) (*AlterColumnFamilyResponse, error)
+	DeleteColumnFamily(ctx context.Context, in *DeleteColumnFamilyRequest, opts ...grpc.CallOption) (*DeleteColumnFamilyResponse, error)
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error)
+	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*DropIndexResponse, error)
+	ListIndexes(ctx context.Context, in *ListIndexesRequest, opts ...grpc.CallOption) (*ListIndexesResponse, error)
 	// CRUD RPCs
 	InsertOrReplace(ctx context.Context, in *InsertOrReplaceRequest, opts ...grpc.CallOption) (*InsertOrReplaceResponse, error)
 	FindById(ctx context.Context, in *FindByIdRequest, opts ...grpc.CallOption) (*FindByIdResponse, error)
 	Find(ctx context.Context, in *FindRequest, opts ...grpc.CallOption) (MapRDbServer_FindClient, error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Change data capture RPCs
+	Changes(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (MapRDbServer_ChangesClient, error)
 }
 
 type mapRDbServerClient struct {
@@ -76,6 +92,96 @@ func (c *mapRDbServerClient) TableExists(ctx context.Context, in *TableExistsReq
 	return out, nil
 }
 
+func (c *mapRDbServerClient) CreateTableWithDescriptor(ctx context.Context, in *CreateTableWithDescriptorRequest, opts ...grpc.CallOption) (*CreateTableWithDescriptorResponse, error) {
+	out := new(CreateTableWithDescriptorResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/CreateTableWithDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapRDbServerClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error) {
+	out := new(ListTablesResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/ListTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapRDbServerClient) DescribeTable(ctx context.Context, in *DescribeTableRequest, opts ...grpc.CallOption) (*DescribeTableResponse, error) {
+	out := new(DescribeTableResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/DescribeTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapRDbServerClient) AlterTable(ctx context.Context, in *AlterTableRequest, opts ...grpc.CallOption) (*AlterTableResponse, error) {
+	out := new(AlterTableResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/AlterTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapRDbServerClient) AddColumnFamily(ctx context.Context, in *AddColumnFamilyRequest, opts ...grpc.CallOption) (*AddColumnFamilyResponse, error) {
+	out := new(AddColumnFamilyResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/AddColumnFamily", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapRDbServerClient) AlterColumnFamily(ctx context.Context, in *AlterColumnFamilyRequest, opts ...grpc.CallOption) (*AlterColumnFamilyResponse, error) {
+	out := new(AlterColumnFamilyResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/AlterColumnFamily", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapRDbServerClient) DeleteColumnFamily(ctx context.Context, in *DeleteColumnFamilyRequest, opts ...grpc.CallOption) (*DeleteColumnFamilyResponse, error) {
+	out := new(DeleteColumnFamilyResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/DeleteColumnFamily", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapRDbServerClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error) {
+	out := new(CreateIndexResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapRDbServerClient) DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*DropIndexResponse, error) {
+	out := new(DropIndexResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/DropIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapRDbServerClient) ListIndexes(ctx context.Context, in *ListIndexesRequest, opts ...grpc.CallOption) (*ListIndexesResponse, error) {
+	out := new(ListIndexesResponse)
+	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/ListIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mapRDbServerClient) InsertOrReplace(ctx context.Context, in *InsertOrReplaceRequest, opts ...grpc.CallOption) (*InsertOrReplaceResponse, error) {
 	out := new(InsertOrReplaceResponse)
 	err := c.cc.Invoke(ctx, "/com.mapr.data.db.MapRDbServer/InsertOrReplace", in, out, opts...)
@@ -144,6 +250,38 @@ func (c *mapRDbServerClient) Delete(ctx context.Context, in *DeleteRequest, opts
 	return out, nil
 }
 
+func (c *mapRDbServerClient) Changes(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (MapRDbServer_ChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MapRDbServer_ServiceDesc.Streams[1], "/com.mapr.data.db.MapRDbServer/Changes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mapRDbServerChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MapRDbServer_ChangesClient interface {
+	Recv() (*ChangesResponse, error)
+	grpc.ClientStream
+}
+
+type mapRDbServerChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *mapRDbServerChangesClient) Recv() (*ChangesResponse, error) {
+	m := new(ChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // MapRDbServerServer is the server API for MapRDbServer service.
 // All implementations must embed UnimplementedMapRDbServerServer
 // for forward compatibility
@@ -154,12 +292,24 @@ type MapRDbServerServer interface {
 	CreateTable(context.Context, *CreateTableRequest) (*CreateTableResponse, error)
 	DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error)
 	TableExists(context.Context, *TableExistsRequest) (*TableExistsResponse, error)
+	CreateTableWithDescriptor(context.Context, *CreateTableWithDescriptorRequest) (*CreateTableWithDescriptorResponse, error)
+	ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error)
+	DescribeTable(context.Context, *DescribeTableRequest) (*DescribeTableResponse, error)
+	AlterTable(context.Context, *AlterTableRequest) (*AlterTableResponse, error)
+	AddColumnFamily(context.Context, *AddColumnFamilyRequest) (*AddColumnFamilyResponse, error)
+	AlterColumnFamily(context.Context, *AlterColumnFamilyRequest) (*AlterColumnFamilyResponse, error)
+	DeleteColumnFamily(context.Context, *DeleteColumnFamilyRequest) (*DeleteColumnFamilyResponse, error)
+	CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error)
+	DropIndex(context.Context, *DropIndexRequest) (*DropIndexResponse, error)
+	ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error)
 	// CRUD RPCs
 	InsertOrReplace(context.Context, *InsertOrReplaceRequest) (*InsertOrReplaceResponse, error)
 	FindById(context.Context, *FindByIdRequest) (*FindByIdResponse, error)
 	Find(*FindRequest, MapRDbServer_FindServer) error
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Change data capture RPCs
+	Changes(*ChangesRequest, MapRDbServer_ChangesServer) error
 	mustEmbedUnimplementedMapRDbServerServer()
 }
 
@@ -179,6 +329,36 @@ func (UnimplementedMapRDbServerServer) DeleteTable(context.Context, *DeleteTable
 func (UnimplementedMapRDbServerServer) TableExists(context.Context, *TableExistsRequest) (*TableExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TableExists not implemented")
 }
+func (UnimplementedMapRDbServerServer) CreateTableWithDescriptor(context.Context, *CreateTableWithDescriptorRequest) (*CreateTableWithDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTableWithDescriptor not implemented")
+}
+func (UnimplementedMapRDbServerServer) ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTables not implemented")
+}
+func (UnimplementedMapRDbServerServer) DescribeTable(context.Context, *DescribeTableRequest) (*DescribeTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTable not implemented")
+}
+func (UnimplementedMapRDbServerServer) AlterTable(context.Context, *AlterTableRequest) (*AlterTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterTable not implemented")
+}
+func (UnimplementedMapRDbServerServer) AddColumnFamily(context.Context, *AddColumnFamilyRequest) (*AddColumnFamilyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddColumnFamily not implemented")
+}
+func (UnimplementedMapRDbServerServer) AlterColumnFamily(context.Context, *AlterColumnFamilyRequest) (*AlterColumnFamilyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterColumnFamily not implemented")
+}
+func (UnimplementedMapRDbServerServer) DeleteColumnFamily(context.Context, *DeleteColumnFamilyRequest) (*DeleteColumnFamilyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteColumnFamily not implemented")
+}
+func (UnimplementedMapRDbServerServer) CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
+}
+func (UnimplementedMapRDbServerServer) DropIndex(context.Context, *DropIndexRequest) (*DropIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
+}
+func (UnimplementedMapRDbServerServer) ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexes not implemented")
+}
 func (UnimplementedMapRDbServerServer) InsertOrReplace(context.Context, *InsertOrReplaceRequest) (*InsertOrReplaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertOrReplace not implemented")
 }
@@ -194,6 +374,9 @@ func (UnimplementedMapRDbServerServer) Update(context.Context, *UpdateRequest) (
 func (UnimplementedMapRDbServerServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedMapRDbServerServer) Changes(*ChangesRequest, MapRDbServer_ChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Changes not implemented")
+}
 func (UnimplementedMapRDbServerServer) mustEmbedUnimplementedMapRDbServerServer() {}
 
 // UnsafeMapRDbServerServer may be embedded to opt out of forward compatibility for this service.
@@ -279,6 +462,186 @@ func _MapRDbServer_TableExists_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MapRDbServer_CreateTableWithDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableWithDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).CreateTableWithDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/CreateTableWithDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).CreateTableWithDescriptor(ctx, req.(*CreateTableWithDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapRDbServer_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).ListTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/ListTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).ListTables(ctx, req.(*ListTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapRDbServer_DescribeTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).DescribeTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/DescribeTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).DescribeTable(ctx, req.(*DescribeTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapRDbServer_AlterTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).AlterTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/AlterTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).AlterTable(ctx, req.(*AlterTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapRDbServer_AddColumnFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddColumnFamilyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).AddColumnFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/AddColumnFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).AddColumnFamily(ctx, req.(*AddColumnFamilyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapRDbServer_AlterColumnFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterColumnFamilyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).AlterColumnFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/AlterColumnFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).AlterColumnFamily(ctx, req.(*AlterColumnFamilyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapRDbServer_DeleteColumnFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteColumnFamilyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).DeleteColumnFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/DeleteColumnFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).DeleteColumnFamily(ctx, req.(*DeleteColumnFamilyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapRDbServer_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).CreateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/CreateIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapRDbServer_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).DropIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/DropIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).DropIndex(ctx, req.(*DropIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapRDbServer_ListIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapRDbServerServer).ListIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/com.mapr.data.db.MapRDbServer/ListIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapRDbServerServer).ListIndexes(ctx, req.(*ListIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MapRDbServer_InsertOrReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InsertOrReplaceRequest)
 	if err := dec(in); err != nil {
@@ -372,6 +735,27 @@ func _MapRDbServer_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MapRDbServer_Changes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MapRDbServerServer).Changes(m, &mapRDbServerChangesServer{stream})
+}
+
+type MapRDbServer_ChangesServer interface {
+	Send(*ChangesResponse) error
+	grpc.ServerStream
+}
+
+type mapRDbServerChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *mapRDbServerChangesServer) Send(m *ChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // MapRDbServer_ServiceDesc is the grpc.ServiceDesc for MapRDbServer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -395,6 +779,46 @@ var MapRDbServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TableExists",
 			Handler:    _MapRDbServer_TableExists_Handler,
 		},
+		{
+			MethodName: "CreateTableWithDescriptor",
+			Handler:    _MapRDbServer_CreateTableWithDescriptor_Handler,
+		},
+		{
+			MethodName: "ListTables",
+			Handler:    _MapRDbServer_ListTables_Handler,
+		},
+		{
+			MethodName: "DescribeTable",
+			Handler:    _MapRDbServer_DescribeTable_Handler,
+		},
+		{
+			MethodName: "AlterTable",
+			Handler:    _MapRDbServer_AlterTable_Handler,
+		},
+		{
+			MethodName: "AddColumnFamily",
+			Handler:    _MapRDbServer_AddColumnFamily_Handler,
+		},
+		{
+			MethodName: "AlterColumnFamily",
+			Handler:    _MapRDbServer_AlterColumnFamily_Handler,
+		},
+		{
+			MethodName: "DeleteColumnFamily",
+			Handler:    _MapRDbServer_DeleteColumnFamily_Handler,
+		},
+		{
+			MethodName: "CreateIndex",
+			Handler:    _MapRDbServer_CreateIndex_Handler,
+		},
+		{
+			MethodName: "DropIndex",
+			Handler:    _MapRDbServer_DropIndex_Handler,
+		},
+		{
+			MethodName: "ListIndexes",
+			Handler:    _MapRDbServer_ListIndexes_Handler,
+		},
 		{
 			MethodName: "InsertOrReplace",
 			Handler:    _MapRDbServer_InsertOrReplace_Handler,
@@ -418,6 +842,11 @@ var MapRDbServer_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MapRDbServer_Find_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Changes",
+			Handler:       _MapRDbServer_Changes_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "maprdb-server.proto",
 }
@@ -0,0 +1,181 @@
+package maprdbtest
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	client "github.com/mapr/maprdb-go-client"
+	"google.golang.org/grpc/status"
+)
+
+// change is a record of table changelog, before is nil for inserts and after is nil for deletes
+type change struct {
+	changeType    client.ChangeRecordType
+	id            interface{}
+	before        map[string]interface{}
+	after         map[string]interface{}
+	changedFields []string
+	timestamp     time.Time
+}
+
+// record appends change of the document to the changelog of the table and wakes up change streams.
+// Documents of the table are never modified in place, so they are kept in the changelog without copying.
+func (server *Server) record(table *table, before, after map[string]interface{}) {
+	record := &change{before: before, after: after, timestamp: time.Now()}
+	switch {
+	case before == nil:
+		record.changeType = client.ChangeRecordType_CHANGE_INSERT
+		record.id = after["_id"]
+	case after == nil:
+		record.changeType = client.ChangeRecordType_CHANGE_DELETE
+		record.id = before["_id"]
+	default:
+		record.changeType = client.ChangeRecordType_CHANGE_UPDATE
+		record.id = after["_id"]
+	}
+	record.changedFields = changedFields(before, after, "")
+	table.changes = append(table.changes, record)
+	close(server.changed)
+	server.changed = make(chan struct{})
+}
+
+// changedFields returns sorted field paths which differ in the documents, nested maps are compared recursively
+func changedFields(before, after map[string]interface{}, prefix string) []string {
+	var fields []string
+	for key := range before {
+		if _, ok := after[key]; !ok && key != "_id" {
+			fields = append(fields, prefix+key)
+		}
+	}
+	for key, value := range after {
+		if key == "_id" {
+			continue
+		}
+		previous, ok := before[key]
+		previousMap, previousIsMap := previous.(map[string]interface{})
+		valueMap, valueIsMap := value.(map[string]interface{})
+		if ok && previousIsMap && valueIsMap {
+			fields = append(fields, changedFields(previousMap, valueMap, prefix+key+".")...)
+		} else if !ok || !reflect.DeepEqual(previous, value) {
+			fields = append(fields, prefix+key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// matches checks whether the change touches one of the field paths, all changes match empty filter
+func (change *change) matches(fieldPaths []string) bool {
+	if len(fieldPaths) == 0 {
+		return true
+	}
+	for _, fieldPath := range fieldPaths {
+		for _, field := range change.changedFields {
+			if field == fieldPath || strings.HasPrefix(field, fieldPath+".") || strings.HasPrefix(fieldPath, field+".") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// response converts the change into ChangesResponse, position is the resume token of the change
+func (change *change) response(position int, includeDocuments bool) (*client.ChangesResponse, error) {
+	jsonId, err := encodeMap(map[string]interface{}{"_id": change.id})
+	if err != nil {
+		return nil, err
+	}
+	response := &client.ChangesResponse{
+		Error:             toRpcError(nil),
+		PayloadEncoding:   client.PayloadEncoding_JSON_ENCODING,
+		Type:              change.changeType,
+		ResumeToken:       strconv.Itoa(position),
+		Timestamp:         change.timestamp.UnixMilli(),
+		ChangedFieldPaths: change.changedFields,
+		Id:                &client.ChangesResponse_JsonId{JsonId: jsonId},
+	}
+	if !includeDocuments {
+		return response, nil
+	}
+	if change.before != nil {
+		jsonBefore, err := encodeMap(change.before)
+		if err != nil {
+			return nil, err
+		}
+		response.Before = &client.ChangesResponse_JsonBefore{JsonBefore: jsonBefore}
+	}
+	if change.after != nil {
+		jsonAfter, err := encodeMap(change.after)
+		if err != nil {
+			return nil, err
+		}
+		response.After = &client.ChangesResponse_JsonAfter{JsonAfter: jsonAfter}
+	}
+	return response, nil
+}
+
+// Changes RPC streams changes of the table made after the resume token until the request is cancelled.
+// Every table has a single changelog, so changelog of the request is ignored.
+func (server *Server) Changes(request *client.ChangesRequest, stream client.MapRDbServer_ChangesServer) error {
+	position, err := server.changesPosition(request)
+	if err != nil {
+		return stream.Send(&client.ChangesResponse{Error: toRpcError(err)})
+	}
+	for {
+		server.mutex.RLock()
+		table, err := server.getTable(request.GetTablePath(), request.GetPayloadEncoding())
+		var pending []*change
+		if err == nil && position < len(table.changes) {
+			pending = table.changes[position:]
+		}
+		changed := server.changed
+		server.mutex.RUnlock()
+		if err != nil {
+			return stream.Send(&client.ChangesResponse{Error: toRpcError(err)})
+		}
+		for _, change := range pending {
+			position++
+			if !change.matches(request.GetFieldPaths()) {
+				continue
+			}
+			response, err := change.response(position, request.GetIncludeDocuments())
+			if err != nil {
+				return stream.Send(&client.ChangesResponse{Error: toRpcError(err)})
+			}
+			if err = stream.Send(response); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-changed:
+		}
+	}
+}
+
+// changesPosition validates the request and returns index of the first change which should be streamed
+func (server *Server) changesPosition(request *client.ChangesRequest) (int, error) {
+	for _, fieldPath := range request.GetFieldPaths() {
+		if _, err := parsePath(fieldPath); err != nil {
+			return 0, err
+		}
+	}
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+	table, err := server.getTable(request.GetTablePath(), request.GetPayloadEncoding())
+	if err != nil {
+		return 0, err
+	}
+	if len(request.GetResumeToken()) == 0 {
+		return len(table.changes), nil
+	}
+	position, err := strconv.Atoi(request.GetResumeToken())
+	if err != nil || position < 0 {
+		return 0, newError(client.ErrorCode_INVALID_ARGUMENT, "invalid resume token %v", request.GetResumeToken())
+	}
+	return position, nil
+}
@@ -0,0 +1,28 @@
+package maprdbtest
+
+import (
+	client "github.com/mapr/maprdb-go-client"
+)
+
+// matchesCondition decodes OJAI condition and evaluates it against the document
+func matchesCondition(jsonCondition string, document map[string]interface{}) (bool, error) {
+	condition, err := decodeMap(jsonCondition)
+	if err != nil {
+		return false, err
+	}
+	return evaluate(condition, document)
+}
+
+// evaluate returns true if the condition map holds for the document.
+// The condition is evaluated with the client-side evaluator, so the fake server shares its semantics.
+func evaluate(condition map[string]interface{}, document map[string]interface{}) (bool, error) {
+	result, err := client.EvaluateConditionMap(condition, client.MakeDocumentFromMap(document))
+	if err != nil {
+		return false, newError(client.ErrorCode_INVALID_ARGUMENT, "%v", err)
+	}
+	return result, nil
+}
+
+func invalidCondition(operation string, operand interface{}) error {
+	return newError(client.ErrorCode_INVALID_ARGUMENT, "invalid %v operand %v", operation, describe(operand))
+}
@@ -0,0 +1,41 @@
+package maprdbtest
+
+import (
+	"fmt"
+
+	client "github.com/mapr/maprdb-go-client"
+)
+
+// rpcError is an error with ErrorCode which is returned to the client inside RpcError message
+type rpcError struct {
+	code    client.ErrorCode
+	message string
+}
+
+func (err *rpcError) Error() string {
+	return fmt.Sprintf("%v: %v", err.code, err.message)
+}
+
+// newError creates new rpcError with given code and formatted message
+func newError(code client.ErrorCode, format string, args ...interface{}) error {
+	return &rpcError{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func tableNotFound(tablePath string) error {
+	return newError(client.ErrorCode_TABLE_NOT_FOUND, "table %v not found", tablePath)
+}
+
+func documentNotFound(key string) error {
+	return newError(client.ErrorCode_DOCUMENT_NOT_FOUND, "document with _id %v not found", key)
+}
+
+// toRpcError converts error into RpcError message, nil error is converted into NO_ERROR
+func toRpcError(err error) *client.RpcError {
+	if err == nil {
+		return &client.RpcError{ErrCode: client.ErrorCode_NO_ERROR}
+	}
+	if e, ok := err.(*rpcError); ok {
+		return &client.RpcError{ErrCode: e.code, ErrorMessage: e.message}
+	}
+	return &client.RpcError{ErrCode: client.ErrorCode_UNKNOWN_ERROR, ErrorMessage: err.Error()}
+}
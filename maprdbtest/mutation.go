@@ -0,0 +1,246 @@
+package maprdbtest
+
+import (
+	"reflect"
+
+	client "github.com/mapr/maprdb-go-client"
+)
+
+// Order in which mutation operations are applied
+var mutationOrder = []string{"$set", "$put", "$merge", "$append", "$increment", "$decrement", "$delete"}
+
+// applyMutation applies OJAI DocumentMutation map to the document and returns updated document
+func applyMutation(document, mutation map[string]interface{}) (map[string]interface{}, error) {
+	for operation := range mutation {
+		if !contains(mutationOrder, operation) {
+			return nil, newError(client.ErrorCode_ILLEGAL_MUTATION, "unsupported mutation operation %v", operation)
+		}
+	}
+	for _, operation := range mutationOrder {
+		value, ok := mutation[operation]
+		if !ok {
+			continue
+		}
+		if operation == "$delete" {
+			err := applyDelete(document, value)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		entries, err := mutationEntries(value)
+		if err != nil {
+			return nil, err
+		}
+		for fieldPath, operand := range entries {
+			path, err := parsePath(fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			if !path[0].IsIndex() && path[0].Name() == "_id" {
+				return nil, newError(client.ErrorCode_ILLEGAL_MUTATION, "_id field cannot be updated")
+			}
+			existing, exists := getPath(document, path)
+			newValue, err := mutate(operation, existing, exists, operand)
+			if err != nil {
+				return nil, err
+			}
+			err = setPath(document, path, newValue)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return document, nil
+}
+
+// mutationEntries converts {path: value} or [{path: value}, ...] into single map
+func mutationEntries(value interface{}) (map[string]interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case []interface{}:
+		entries := make(map[string]interface{})
+		for _, element := range v {
+			m, ok := element.(map[string]interface{})
+			if !ok {
+				return nil, newError(client.ErrorCode_ILLEGAL_MUTATION, "invalid mutation %v", describe(element))
+			}
+			for key, operand := range m {
+				entries[key] = operand
+			}
+		}
+		return entries, nil
+	default:
+		return nil, newError(client.ErrorCode_ILLEGAL_MUTATION, "invalid mutation %v", describe(value))
+	}
+}
+
+// applyDelete removes field path or list of field paths from the document
+func applyDelete(document map[string]interface{}, value interface{}) error {
+	var fieldPaths []interface{}
+	if list, ok := value.([]interface{}); ok {
+		fieldPaths = list
+	} else {
+		fieldPaths = []interface{}{value}
+	}
+	for _, fieldPath := range fieldPaths {
+		s, ok := fieldPath.(string)
+		if !ok {
+			return newError(client.ErrorCode_ILLEGAL_MUTATION, "invalid $delete field path %v", describe(fieldPath))
+		}
+		path, err := parsePath(s)
+		if err != nil {
+			return err
+		}
+		deletePath(document, path)
+	}
+	return nil
+}
+
+// mutate computes new value of the field for given mutation operation
+func mutate(operation string, existing interface{}, exists bool, operand interface{}) (interface{}, error) {
+	switch operation {
+	case "$set":
+		if exists && existing != nil && operand != nil && reflect.TypeOf(existing) != reflect.TypeOf(operand) {
+			return nil, newError(client.ErrorCode_ILLEGAL_MUTATION,
+				"$set can't change type of %v to %v", describe(existing), describe(operand))
+		}
+		return operand, nil
+	case "$put":
+		return operand, nil
+	case "$merge":
+		source, ok := operand.(map[string]interface{})
+		if !ok {
+			return nil, newError(client.ErrorCode_ILLEGAL_MUTATION, "$merge value %v is not a map", describe(operand))
+		}
+		if !exists {
+			return source, nil
+		}
+		target, ok := existing.(map[string]interface{})
+		if !ok {
+			return nil, newError(client.ErrorCode_ILLEGAL_MUTATION, "$merge target %v is not a map", describe(existing))
+		}
+		for key, value := range source {
+			target[key] = value
+		}
+		return target, nil
+	case "$append":
+		if !exists {
+			return operand, nil
+		}
+		switch e := existing.(type) {
+		case string:
+			if s, ok := operand.(string); ok {
+				return e + s, nil
+			}
+		case []interface{}:
+			if list, ok := operand.([]interface{}); ok {
+				return append(e, list...), nil
+			}
+			return append(e, operand), nil
+		}
+		return nil, newError(client.ErrorCode_ILLEGAL_MUTATION,
+			"can't append %v to %v", describe(operand), describe(existing))
+	case "$increment", "$decrement":
+		if operation == "$decrement" {
+			operand = negate(operand)
+		}
+		if !exists {
+			return operand, nil
+		}
+		sum, ok := add(existing, operand)
+		if !ok {
+			return nil, newError(client.ErrorCode_ILLEGAL_MUTATION,
+				"%v can't be applied to %v", operation, describe(existing))
+		}
+		return sum, nil
+	}
+	return nil, newError(client.ErrorCode_ILLEGAL_MUTATION, "unsupported mutation operation %v", operation)
+}
+
+// add sums two numeric values, integer sum keeps the type of the existing value like OJAI does
+func add(a, b interface{}) (interface{}, bool) {
+	ai, aIsInt := toInt(a)
+	bi, bIsInt := toInt(b)
+	if aIsInt && bIsInt {
+		switch a.(type) {
+		case int8:
+			return int8(ai + bi), true
+		case int16:
+			return int16(ai + bi), true
+		case int32:
+			return int32(ai + bi), true
+		case int64:
+			return ai + bi, true
+		}
+		return int(ai + bi), true
+	}
+	af, aOk := toFloat(a)
+	bf, bOk := toFloat(b)
+	if !aOk || !bOk {
+		return nil, false
+	}
+	if _, ok := a.(float32); ok {
+		return float32(af + bf), true
+	}
+	return af + bf, true
+}
+
+func negate(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return -v
+	case int8:
+		return -v
+	case int16:
+		return -v
+	case int32:
+		return -v
+	case int64:
+		return -v
+	case float64:
+		return -v
+	case float32:
+		return -v
+	}
+	return value
+}
+
+func toInt(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	if i, ok := toInt(value); ok {
+		return float64(i), true
+	}
+	switch v := value.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func contains(list []string, element string) bool {
+	for _, e := range list {
+		if e == element {
+			return true
+		}
+	}
+	return false
+}
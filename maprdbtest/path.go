@@ -0,0 +1,104 @@
+package maprdbtest
+
+import (
+	client "github.com/mapr/maprdb-go-client"
+)
+
+// parsePath parses field path in dot separated notation with array indexes, e.g. a.b[0].`c.d`,
+// [] segment isn't allowed since the path must select a single value
+func parsePath(fieldPath string) ([]client.FieldSegment, error) {
+	path, err := client.MakeFieldPath(fieldPath)
+	if err != nil {
+		return nil, newError(client.ErrorCode_INVALID_ARGUMENT, "%v", err)
+	}
+	segments := path.Segments()
+	for _, s := range segments {
+		if s.IsAnyIndex() {
+			return nil, newError(client.ErrorCode_INVALID_ARGUMENT, "invalid index in field path %v", fieldPath)
+		}
+	}
+	return segments, nil
+}
+
+// getPath returns value from the document at given path
+func getPath(document map[string]interface{}, path []client.FieldSegment) (interface{}, bool) {
+	var current interface{} = document
+	for _, s := range path {
+		if s.IsIndex() {
+			array, ok := current.([]interface{})
+			if !ok || s.Index() >= len(array) {
+				return nil, false
+			}
+			current = array[s.Index()]
+		} else {
+			m, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if current, ok = m[s.Name()]; !ok {
+				return nil, false
+			}
+		}
+	}
+	return current, true
+}
+
+// setPath sets value into the document at given path, missing intermediate maps and arrays are created
+func setPath(document map[string]interface{}, path []client.FieldSegment, value interface{}) error {
+	_, err := setSegment(document, path, value)
+	return err
+}
+
+func setSegment(container interface{}, path []client.FieldSegment, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	s := path[0]
+	if s.IsIndex() {
+		array, ok := container.([]interface{})
+		if container != nil && !ok {
+			return nil, newError(client.ErrorCode_ILLEGAL_MUTATION, "value %v is not an array", describe(container))
+		}
+		for len(array) <= s.Index() {
+			array = append(array, nil)
+		}
+		element, err := setSegment(array[s.Index()], path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		array[s.Index()] = element
+		return array, nil
+	}
+	m, ok := container.(map[string]interface{})
+	if container != nil && !ok {
+		return nil, newError(client.ErrorCode_ILLEGAL_MUTATION, "value %v is not a map", describe(container))
+	}
+	if m == nil {
+		m = make(map[string]interface{})
+	}
+	element, err := setSegment(m[s.Name()], path[1:], value)
+	if err != nil {
+		return nil, err
+	}
+	m[s.Name()] = element
+	return m, nil
+}
+
+// deletePath removes value from the document at given path if it exists
+func deletePath(document map[string]interface{}, path []client.FieldSegment) {
+	parent, ok := getPath(document, path[:len(path)-1])
+	if !ok {
+		return
+	}
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		if !last.IsIndex() {
+			delete(p, last.Name())
+		}
+	case []interface{}:
+		if last.IsIndex() && last.Index() < len(p) {
+			setPath(document, path[:len(path)-1], append(p[:last.Index():last.Index()], p[last.Index()+1:]...))
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package maprdbtest
+
+import (
+	"encoding/json"
+	"sort"
+
+	client "github.com/mapr/maprdb-go-client"
+)
+
+// query is a parsed OJAI query
+type query struct {
+	where   map[string]interface{}
+	selects []string
+	orderBy []orderField
+	offset  int
+	limit   int
+}
+
+// orderField is a single $orderby entry
+type orderField struct {
+	path       []client.FieldSegment
+	descending bool
+}
+
+// parseQuery parses JSON encoded OJAI query with $select, $where, $orderby, $offset, $limit and $options
+func parseQuery(jsonQuery string) (*query, error) {
+	result := &query{limit: -1}
+	if len(jsonQuery) == 0 {
+		return result, nil
+	}
+	content := make(map[string]interface{})
+	err := json.Unmarshal([]byte(jsonQuery), &content)
+	if err != nil {
+		return nil, newError(client.ErrorCode_DECODING_ERROR, "couldn't decode query %v: %v", jsonQuery, err)
+	}
+	for key, value := range content {
+		switch key {
+		case "$select":
+			result.selects, err = parseSelect(value)
+		case "$where":
+			result.where, err = parseWhere(value)
+		case "$orderby":
+			result.orderBy, err = parseOrderBy(value)
+		case "$offset":
+			result.offset, err = parseCount(key, value)
+		case "$limit":
+			result.limit, err = parseCount(key, value)
+		case "$options":
+			// index hints are accepted and ignored, tables of the fake server have no secondary indexes
+			if _, ok := value.(map[string]interface{}); !ok {
+				err = newError(client.ErrorCode_INVALID_ARGUMENT, "invalid $options %v", describe(value))
+			}
+		default:
+			err = newError(client.ErrorCode_INVALID_ARGUMENT, "unsupported query operation %v", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func parseSelect(value interface{}) ([]string, error) {
+	var fieldPaths []interface{}
+	if list, ok := value.([]interface{}); ok {
+		fieldPaths = list
+	} else {
+		fieldPaths = []interface{}{value}
+	}
+	var selects []string
+	for _, fieldPath := range fieldPaths {
+		s, ok := fieldPath.(string)
+		if !ok {
+			return nil, invalidCondition("$select", value)
+		}
+		selects = append(selects, s)
+	}
+	return selects, nil
+}
+
+// parseWhere converts condition back into OJAI JSON, so OJAI types are decoded in the same way as in documents
+func parseWhere(value interface{}) (map[string]interface{}, error) {
+	if _, ok := value.(map[string]interface{}); !ok {
+		return nil, invalidCondition("$where", value)
+	}
+	ser, err := json.Marshal(value)
+	if err != nil {
+		return nil, newError(client.ErrorCode_DECODING_ERROR, "couldn't decode condition: %v", err)
+	}
+	return decodeMap(string(ser))
+}
+
+func parseOrderBy(value interface{}) ([]orderField, error) {
+	var entries []interface{}
+	if list, ok := value.([]interface{}); ok {
+		entries = list
+	} else {
+		entries = []interface{}{value}
+	}
+	var orderBy []orderField
+	for _, entry := range entries {
+		switch e := entry.(type) {
+		case string:
+			path, err := parsePath(e)
+			if err != nil {
+				return nil, err
+			}
+			orderBy = append(orderBy, orderField{path: path})
+		case map[string]interface{}:
+			for fieldPath, order := range e {
+				path, err := parsePath(fieldPath)
+				if err != nil {
+					return nil, err
+				}
+				orderBy = append(orderBy, orderField{path: path, descending: order == "desc"})
+			}
+		default:
+			return nil, invalidCondition("$orderby", value)
+		}
+	}
+	return orderBy, nil
+}
+
+func parseCount(operation string, value interface{}) (int, error) {
+	count, ok := value.(float64)
+	if !ok || count < 0 {
+		return 0, invalidCondition(operation, value)
+	}
+	return int(count), nil
+}
+
+// execute returns documents of the table which match the query in the requested order
+func (query *query) execute(table *table) ([]map[string]interface{}, error) {
+	var documents []map[string]interface{}
+	for _, key := range table.sortedKeys() {
+		document := table.documents[key]
+		if query.where != nil {
+			matches, err := evaluate(query.where, document)
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				continue
+			}
+		}
+		documents = append(documents, document)
+	}
+	if len(query.orderBy) != 0 {
+		sort.SliceStable(documents, func(i, j int) bool {
+			return query.less(documents[i], documents[j])
+		})
+	}
+	if query.offset >= len(documents) {
+		return nil, nil
+	}
+	documents = documents[query.offset:]
+	if query.limit >= 0 && query.limit < len(documents) {
+		documents = documents[:query.limit]
+	}
+	var result []map[string]interface{}
+	for _, document := range documents {
+		result = append(result, project(document, query.selects))
+	}
+	return result, nil
+}
+
+// less compares documents by $orderby fields, missing values are ordered first
+func (query *query) less(a, b map[string]interface{}) bool {
+	for _, field := range query.orderBy {
+		av, aExists := getPath(a, field.path)
+		bv, bExists := getPath(b, field.path)
+		result := 0
+		switch {
+		case !aExists && bExists:
+			result = -1
+		case aExists && !bExists:
+			result = 1
+		case aExists && bExists:
+			result = client.CompareValues(av, bv)
+		}
+		if field.descending {
+			result = -result
+		}
+		if result != 0 {
+			return result < 0
+		}
+	}
+	return false
+}
+
+// plan returns query plan in the format of MapR-DB DBDocumentStream, all documents of the table are scanned
+func (query *query) plan(tablePath string, table *table) map[string]interface{} {
+	projection := query.selects
+	if projection == nil {
+		projection = []string{}
+	}
+	parameters := map[string]interface{}{
+		"queryConditionPath": query.where != nil,
+		"indexName":          "_id",
+		"projectionPath":     projection,
+		"primaryTable":       tablePath,
+		"estimatedRowCount":  len(table.documents),
+	}
+	if query.where != nil {
+		condition, err := encodeMap(query.where)
+		if err == nil {
+			parameters["condition"] = condition
+		}
+	}
+	return map[string]interface{}{
+		"QueryPlan": []interface{}{[]interface{}{map[string]interface{}{
+			"streamName": "DBDocumentStream",
+			"parameters": parameters,
+		}}},
+	}
+}
@@ -0,0 +1,389 @@
+// Package maprdbtest provides an in-process fake of the MapR Data Access Gateway
+// for unit testing of applications which use the Golang OJAI client.
+//
+// The Server keeps tables in memory and serves MapRDbServer gRPC service over bufconn listener,
+// so a Connection can be created without network and real cluster:
+//
+//	server := maprdbtest.NewServer()
+//	defer server.Close()
+//	connection, err := client.MakeConnectionWithRetryOptions(server.ConnectionString(), server.ConnectionOptions())
+//
+// or with functional options:
+//
+//	connection, err := client.NewConnection(ctx, server.ConnectionString(), server.Options()...)
+package maprdbtest
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+
+	client "github.com/mapr/maprdb-go-client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Size of the in-memory connection buffer
+const bufferSize = 1024 * 1024
+
+// Host name which is used in connection string of the fake server
+const bufferHost = "bufnet"
+
+// Server is an in-memory implementation of MapRDbServer gRPC service
+type Server struct {
+	client.UnimplementedMapRDbServerServer
+	mutex    sync.RWMutex
+	tables   map[string]*table
+	changed  chan struct{}
+	listener *bufconn.Listener
+	server   *grpc.Server
+}
+
+// NewServer creates and starts new Server without tables
+func NewServer() *Server {
+	server := &Server{
+		tables:   make(map[string]*table),
+		changed:  make(chan struct{}),
+		listener: bufconn.Listen(bufferSize),
+		server:   grpc.NewServer(),
+	}
+	client.RegisterMapRDbServerServer(server.server, server)
+	go server.server.Serve(server.listener)
+	return server
+}
+
+// Close stops the gRPC server and closes the listener
+func (server *Server) Close() {
+	server.server.Stop()
+	server.listener.Close()
+}
+
+// Dialer returns function which dials the in-memory listener of the Server
+func (server *Server) Dialer() func(context.Context, string) (net.Conn, error) {
+	return func(ctx context.Context, address string) (net.Conn, error) {
+		return server.listener.DialContext(ctx)
+	}
+}
+
+// ConnectionString returns connection string which can be used with ConnectionOptions of the Server
+func (server *Server) ConnectionString() string {
+	return "ojai:mapr@" + bufferHost + "?auth=basic;user=mapr;password=mapr"
+}
+
+// ConnectionOptions returns ConnectionOptions with dialer of the Server
+func (server *Server) ConnectionOptions() *client.ConnectionOptions {
+	return &client.ConnectionOptions{
+		MaxAttempt:         1,
+		WaitBetweenSeconds: 1,
+		CallTimeoutSeconds: 10,
+		DialOptions:        []grpc.DialOption{grpc.WithContextDialer(server.Dialer())},
+	}
+}
+
+// Options returns ConnectionOption list with dialer of the Server which can be used with NewConnection
+func (server *Server) Options() []client.ConnectionOption {
+	return []client.ConnectionOption{
+		client.WithRetry(1, time.Second),
+		client.WithCallTimeout(10 * time.Second),
+		client.WithDialOptions(grpc.WithContextDialer(server.Dialer())),
+	}
+}
+
+// Connect creates new Connection to the Server
+func (server *Server) Connect() (*client.Connection, error) {
+	return client.MakeConnectionWithRetryOptions(server.ConnectionString(), server.ConnectionOptions())
+}
+
+// Ping RPC
+func (server *Server) Ping(ctx context.Context, request *client.PingRequest) (*client.PingResponse, error) {
+	return &client.PingResponse{}, nil
+}
+
+// CreateTable RPC creates new empty table or returns TABLE_ALREADY_EXISTS
+func (server *Server) CreateTable(
+	ctx context.Context,
+	request *client.CreateTableRequest,
+) (*client.CreateTableResponse, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	if _, ok := server.tables[request.GetTablePath()]; ok {
+		return &client.CreateTableResponse{Error: toRpcError(
+			newError(client.ErrorCode_TABLE_ALREADY_EXISTS, "table %v already exists", request.GetTablePath()),
+		)}, nil
+	}
+	server.tables[request.GetTablePath()] = newTable()
+	return &client.CreateTableResponse{Error: toRpcError(nil)}, nil
+}
+
+// DeleteTable RPC deletes table or returns TABLE_NOT_FOUND
+func (server *Server) DeleteTable(
+	ctx context.Context,
+	request *client.DeleteTableRequest,
+) (*client.DeleteTableResponse, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	if _, ok := server.tables[request.GetTablePath()]; !ok {
+		return &client.DeleteTableResponse{Error: toRpcError(tableNotFound(request.GetTablePath()))}, nil
+	}
+	delete(server.tables, request.GetTablePath())
+	return &client.DeleteTableResponse{Error: toRpcError(nil)}, nil
+}
+
+// TableExists RPC returns NO_ERROR if table exists otherwise TABLE_NOT_FOUND
+func (server *Server) TableExists(
+	ctx context.Context,
+	request *client.TableExistsRequest,
+) (*client.TableExistsResponse, error) {
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+	if _, ok := server.tables[request.GetTablePath()]; !ok {
+		return &client.TableExistsResponse{Error: toRpcError(tableNotFound(request.GetTablePath()))}, nil
+	}
+	return &client.TableExistsResponse{Error: toRpcError(nil)}, nil
+}
+
+// InsertOrReplace RPC stores the document according to InsertMode of the request
+func (server *Server) InsertOrReplace(
+	ctx context.Context,
+	request *client.InsertOrReplaceRequest,
+) (*client.InsertOrReplaceResponse, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	err := server.insertOrReplace(request)
+	return &client.InsertOrReplaceResponse{Error: toRpcError(err)}, nil
+}
+
+func (server *Server) insertOrReplace(request *client.InsertOrReplaceRequest) error {
+	table, err := server.getTable(request.GetTablePath(), request.GetPayloadEncoding())
+	if err != nil {
+		return err
+	}
+	document, err := decodeMap(request.GetJsonDocument())
+	if err != nil {
+		return err
+	}
+	key, err := documentKey(document)
+	if err != nil {
+		return err
+	}
+	existing, exists := table.documents[key]
+	switch request.GetInsertMode() {
+	case client.InsertMode_INSERT:
+		if exists {
+			return newError(client.ErrorCode_DOCUMENT_ALREADY_EXISTS, "document with _id %v already exists", key)
+		}
+	case client.InsertMode_REPLACE:
+		if !exists {
+			return documentNotFound(key)
+		}
+		if len(request.GetJsonCondition()) != 0 {
+			matches, err := matchesCondition(request.GetJsonCondition(), existing)
+			if err != nil {
+				return err
+			}
+			if !matches {
+				return documentNotFound(key)
+			}
+		}
+	case client.InsertMode_INSERT_OR_REPLACE:
+	default:
+		return newError(client.ErrorCode_INVALID_ARGUMENT, "unsupported insert mode %v", request.GetInsertMode())
+	}
+	table.documents[key] = document
+	server.record(table, existing, document)
+	return nil
+}
+
+// FindById RPC returns projected document if it exists and matches the condition
+func (server *Server) FindById(
+	ctx context.Context,
+	request *client.FindByIdRequest,
+) (*client.FindByIdResponse, error) {
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+	jsonDocument, err := server.findById(request)
+	if err != nil {
+		return &client.FindByIdResponse{Error: toRpcError(err)}, nil
+	}
+	return &client.FindByIdResponse{
+		Error:           toRpcError(nil),
+		PayloadEncoding: client.PayloadEncoding_JSON_ENCODING,
+		Data:            &client.FindByIdResponse_JsonDocument{JsonDocument: jsonDocument},
+	}, nil
+}
+
+func (server *Server) findById(request *client.FindByIdRequest) (string, error) {
+	table, err := server.getTable(request.GetTablePath(), request.GetPayloadEncoding())
+	if err != nil {
+		return "", err
+	}
+	document, key, err := table.lookup(request.GetJsonDocument())
+	if err != nil {
+		return "", err
+	}
+	if document == nil {
+		return "", documentNotFound(key)
+	}
+	if len(request.GetJsonCondition()) != 0 {
+		matches, err := matchesCondition(request.GetJsonCondition(), document)
+		if err != nil {
+			return "", err
+		}
+		if !matches {
+			return "", documentNotFound(key)
+		}
+	}
+	return encodeMap(project(document, request.GetProjections()))
+}
+
+// Find RPC streams documents which match the query, preceded by query plan if it was requested
+func (server *Server) Find(request *client.FindRequest, stream client.MapRDbServer_FindServer) error {
+	server.mutex.RLock()
+	plan, documents, err := server.find(request)
+	server.mutex.RUnlock()
+	if err != nil {
+		return stream.Send(&client.FindResponse{Error: toRpcError(err)})
+	}
+	if request.GetIncludeQueryPlan() {
+		err = stream.Send(&client.FindResponse{
+			Error:           toRpcError(nil),
+			PayloadEncoding: client.PayloadEncoding_JSON_ENCODING,
+			Type:            client.FindResponseType_QUERY_PLAN,
+			Data:            &client.FindResponse_JsonResponse{JsonResponse: plan},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, document := range documents {
+		err = stream.Send(&client.FindResponse{
+			Error:           toRpcError(nil),
+			PayloadEncoding: client.PayloadEncoding_JSON_ENCODING,
+			Type:            client.FindResponseType_RESULT_DOCUMENT,
+			Data:            &client.FindResponse_JsonResponse{JsonResponse: document},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (server *Server) find(request *client.FindRequest) (string, []string, error) {
+	table, err := server.getTable(request.GetTablePath(), request.GetPayloadEncoding())
+	if err != nil {
+		return "", nil, err
+	}
+	query, err := parseQuery(request.GetJsonQuery())
+	if err != nil {
+		return "", nil, err
+	}
+	documents, err := query.execute(table)
+	if err != nil {
+		return "", nil, err
+	}
+	var result []string
+	for _, document := range documents {
+		jsonDocument, err := encodeMap(document)
+		if err != nil {
+			return "", nil, err
+		}
+		result = append(result, jsonDocument)
+	}
+	plan, err := json.Marshal(query.plan(request.GetTablePath(), table))
+	if err != nil {
+		return "", nil, err
+	}
+	return string(plan), result, nil
+}
+
+// Update RPC applies the mutation to the existing document
+func (server *Server) Update(ctx context.Context, request *client.UpdateRequest) (*client.UpdateResponse, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	err := server.update(request)
+	return &client.UpdateResponse{Error: toRpcError(err)}, nil
+}
+
+func (server *Server) update(request *client.UpdateRequest) error {
+	table, err := server.getTable(request.GetTablePath(), request.GetPayloadEncoding())
+	if err != nil {
+		return err
+	}
+	document, key, err := table.lookup(request.GetJsonDocument())
+	if err != nil {
+		return err
+	}
+	if document == nil {
+		return documentNotFound(key)
+	}
+	if len(request.GetJsonCondition()) != 0 {
+		matches, err := matchesCondition(request.GetJsonCondition(), document)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			return documentNotFound(key)
+		}
+	}
+	mutation, err := decodeMap(request.GetJsonMutation())
+	if err != nil {
+		return err
+	}
+	// mutation is applied to the copy, so the document stays unchanged if the mutation is illegal
+	updated, err := applyMutation(copyValue(document).(map[string]interface{}), mutation)
+	if err != nil {
+		return err
+	}
+	table.documents[key] = updated
+	server.record(table, document, updated)
+	return nil
+}
+
+// Delete RPC deletes the document if it exists and matches the condition
+func (server *Server) Delete(ctx context.Context, request *client.DeleteRequest) (*client.DeleteResponse, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	err := server.delete(request)
+	return &client.DeleteResponse{Error: toRpcError(err)}, nil
+}
+
+func (server *Server) delete(request *client.DeleteRequest) error {
+	table, err := server.getTable(request.GetTablePath(), request.GetPayloadEncoding())
+	if err != nil {
+		return err
+	}
+	document, key, err := table.lookup(request.GetJsonDocument())
+	if err != nil {
+		return err
+	}
+	if document == nil {
+		return documentNotFound(key)
+	}
+	if len(request.GetJsonCondition()) != 0 {
+		matches, err := matchesCondition(request.GetJsonCondition(), document)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			return documentNotFound(key)
+		}
+	}
+	delete(table.documents, key)
+	server.record(table, document, nil)
+	return nil
+}
+
+// getTable checks payload encoding of the request and returns table with given path
+func (server *Server) getTable(tablePath string, encoding client.PayloadEncoding) (*table, error) {
+	if encoding != client.PayloadEncoding_JSON_ENCODING {
+		return nil, newError(client.ErrorCode_UNKNOWN_PAYLOAD_ENCODING, "unsupported payload encoding %v", encoding)
+	}
+	table, ok := server.tables[tablePath]
+	if !ok {
+		return nil, tableNotFound(tablePath)
+	}
+	return table, nil
+}
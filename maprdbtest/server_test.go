@@ -0,0 +1,828 @@
+package maprdbtest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	client "github.com/mapr/maprdb-go-client"
+	"github.com/mapr/maprdb-go-client/telemetry"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func makeStore(t *testing.T) (*Server, *client.Connection, *client.DocumentStore) {
+	server := NewServer()
+	connection, err := server.Connect()
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	store, err := connection.CreateStore("/test")
+	if err != nil {
+		connection.Close()
+		server.Close()
+		t.Fatal(err)
+	}
+	return server, connection, store
+}
+
+func TestServer_Stores(t *testing.T) {
+	server, connection, _ := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	exists, err := connection.IsStoreExists("/test")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	_, err = connection.CreateStore("/test")
+	assert.True(t, errors.Is(err, client.ErrTableExists))
+	assert.Nil(t, connection.DeleteStore("/test"))
+	exists, err = connection.IsStoreExists("/test")
+	assert.Nil(t, err)
+	assert.False(t, exists)
+	assert.NotNil(t, connection.DeleteStore("/test"))
+}
+
+func TestServer_Admin(t *testing.T) {
+	server, connection, _ := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	admin := connection.Admin()
+	exists, err := admin.TableExists("/test")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	_, err = admin.CreateTableWithContext(client.MakeTableDescriptor("/other"), context.Background())
+	assert.True(t, errors.Is(err, client.ErrUnsupportedOperation))
+	_, err = admin.ListTables("/")
+	assert.True(t, errors.Is(err, client.ErrUnsupportedOperation))
+	_, err = admin.DescribeTable("/test")
+	assert.True(t, errors.Is(err, client.ErrUnsupportedOperation))
+	_, err = admin.ListIndexes("/test")
+	assert.True(t, errors.Is(err, client.ErrUnsupportedOperation))
+	assert.Nil(t, admin.DeleteTable("/test"))
+}
+
+func TestServer_NewConnection(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	var methods []string
+	interceptor := func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		methods = append(methods, method)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	connection, err := client.NewConnection(context.Background(), server.ConnectionString(),
+		append(server.Options(),
+			client.WithCredentials("admin", "secret"),
+			client.WithUnaryInterceptors(interceptor),
+			client.WithUserAgent("maprdbtest"),
+		)...)
+	assert.Nil(t, err)
+	defer connection.Close()
+	_, err = connection.CreateStore("/test")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"/com.mapr.data.db.MapRDbServer/Ping",
+		"/com.mapr.data.db.MapRDbServer/CreateTable",
+		"/com.mapr.data.db.MapRDbServer/TableExists",
+	}, methods)
+
+	_, err = client.NewConnection(context.Background(), server.ConnectionString()+";unknown=1", server.Options()...)
+	assert.NotNil(t, err)
+	_, err = client.NewConnection(context.Background(), server.ConnectionString(),
+		append(server.Options(), client.WithCallTimeout(0))...)
+	assert.NotNil(t, err)
+}
+
+func TestServer_InsertAndFindById(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	err := store.InsertString(`{"_id": "id1", "name": "John", "address": {"city": "Boston", "zip": 2101}}`)
+	assert.Nil(t, err)
+	err = store.InsertString(`{"_id": "id1", "name": "Jane"}`)
+	assert.True(t, errors.Is(err, client.ErrDocumentExists))
+	err = store.ReplaceString(`{"_id": "id2", "name": "Jane"}`)
+	assert.True(t, errors.Is(err, client.ErrDocumentNotFound))
+
+	doc, err := store.FindByIdString("id1")
+	assert.Nil(t, err)
+	assert.Equal(t, "John", doc.AsMap()["name"])
+	assert.Equal(t, "Boston", doc.AsMap()["address"].(map[string]interface{})["city"])
+
+	doc, err = store.FindByIdStringWithFieldsAndCondition("id1", []string{"address.city"}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"_id":     "id1",
+		"address": map[string]interface{}{"city": "Boston"},
+	}, doc.AsMap())
+
+	doc, err = store.FindByIdString("id2")
+	assert.Nil(t, err)
+	assert.Empty(t, doc.AsMap())
+}
+
+func TestServer_Update(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	assert.Nil(t, store.InsertString(`{"_id": "id1", "count": 1, "tags": ["a"]}`))
+	mutation, err := client.MakeDocumentMutation(
+		client.IncrementInt("count", 2),
+		client.AppendSlice("tags", []interface{}{"b"}),
+		client.Set("nested.value", "x"),
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, store.Update(client.BosiFromString("id1"), client.MosmFromStruct(mutation)))
+
+	doc, err := store.FindByIdString("id1")
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, doc.AsMap()["count"])
+	assert.Equal(t, []interface{}{"a", "b"}, doc.AsMap()["tags"])
+	assert.Equal(t, map[string]interface{}{"value": "x"}, doc.AsMap()["nested"])
+
+	updated, err := store.CheckAndUpdate(
+		client.BosiFromString("id1"),
+		client.MoscFromMap(map[string]interface{}{"$eq": map[string]interface{}{"count": 5}}),
+		client.MosmFromMap(map[string]interface{}{"$set": map[string]interface{}{"count": 10}}),
+	)
+	assert.Nil(t, err)
+	assert.False(t, updated)
+}
+
+func TestServer_Diff(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	original := `{"_id": "id1", "name": "Bob", "version": 1, "tags": ["a"], "address": {"city": "NY", "zip": "10001"}}`
+	assert.Nil(t, store.InsertString(original))
+	concurrent, err := client.MakeDocumentMutation(client.Set("address.zip", "10002"))
+	assert.Nil(t, err)
+	assert.Nil(t, store.Update(client.BosiFromString("id1"), client.MosmFromStruct(concurrent)))
+
+	oldDocument, err := client.MakeDocumentFromJson(original)
+	assert.Nil(t, err)
+	newDocument, err := client.MakeDocumentFromJson(
+		`{"_id": "id1", "name": "Alice", "version": 2, "tags": ["a", "b"],` +
+			` "address": {"city": "NY", "zip": "10001", "street": "Main"}}`)
+	assert.Nil(t, err)
+	mutation, err := client.Diff(oldDocument, newDocument)
+	assert.Nil(t, err)
+	condition, err := client.MakeCondition(client.Equals("version", 1), client.Close())
+	assert.Nil(t, err)
+	_, err = condition.Build()
+	assert.Nil(t, err)
+	id := client.BosiFromString("id1")
+	updated, err := store.CheckAndUpdate(id, client.MoscFromStruct(condition), client.MosmFromStruct(mutation))
+	assert.Nil(t, err)
+	assert.True(t, updated)
+
+	doc, err := store.FindByIdString("id1")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"_id":     "id1",
+		"name":    "Alice",
+		"version": 2,
+		"tags":    []interface{}{"a", "b"},
+		"address": map[string]interface{}{"city": "NY", "zip": "10002", "street": "Main"},
+	}, doc.AsMap())
+
+	updated, err = store.CheckAndUpdate(id, client.MoscFromStruct(condition), client.MosmFromStruct(mutation))
+	assert.Nil(t, err)
+	assert.False(t, updated)
+}
+
+func TestServer_OjaiTypes(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	price, err := client.MakeDecimalFromString("19.99")
+	assert.Nil(t, err)
+	doc, err := client.MakeDocument(
+		client.SetIdString("id1"),
+		client.SetInt32("count", 7),
+		client.SetInt8("level", 1),
+		client.SetDecimal("price", price),
+		client.SetInterval("ttl", client.MakeOIntervalFromDuration(time.Hour)),
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, store.InsertDocument(doc))
+	mutation, err := client.MakeDocumentMutation(client.IncrementInt("count", 3))
+	assert.Nil(t, err)
+	assert.Nil(t, store.Update(client.BosiFromString("id1"), client.MosmFromStruct(mutation)))
+
+	doc, err = store.FindByIdString("id1")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(10), doc.AsMap()["count"])
+	assert.Equal(t, int8(1), doc.AsMap()["level"])
+	found, err := doc.GetDecimal("price")
+	assert.Nil(t, err)
+	assert.Equal(t, "19.99", found.String())
+	ttl, err := doc.GetInterval("ttl")
+	assert.Nil(t, err)
+	assert.Equal(t, time.Hour, ttl.Duration())
+
+	query, err := client.MakeQuery(client.WhereMap(map[string]interface{}{
+		"$gt": map[string]interface{}{"price": client.MakeDecimalFromInt64(19)},
+	}))
+	assert.Nil(t, err)
+	result, err := store.FindQuery(query, &client.FindOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, result.DocumentList(), 1)
+}
+
+func TestServer_Delete(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	assert.Nil(t, store.InsertString(`{"_id": "id1", "age": 30}`))
+	assert.Nil(t, store.InsertString(`{"_id": "id2", "age": 40}`))
+
+	err := store.CheckAndDelete(
+		client.BosiFromString("id1"),
+		client.MoscFromMap(map[string]interface{}{"$gt": map[string]interface{}{"age": 35}}),
+	)
+	assert.Nil(t, err)
+	deleted, err := store.DeleteByIdString("id2")
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+	deleted, err = store.DeleteByIdString("id2")
+	assert.Nil(t, err)
+	assert.False(t, deleted)
+
+	doc, err := store.FindByIdString("id1")
+	assert.Nil(t, err)
+	assert.Equal(t, "id1", doc.AsMap()["_id"])
+}
+
+func TestServer_FindQuery(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	for _, doc := range []string{
+		`{"_id": "a", "age": 35, "name": "Ann"}`,
+		`{"_id": "b", "age": 20, "name": "Bob"}`,
+		`{"_id": "c", "age": 50, "name": "Cid"}`,
+		`{"_id": "d", "age": 41, "name": "Dan"}`,
+	} {
+		assert.Nil(t, store.InsertString(doc))
+	}
+	query, err := client.MakeQuery(
+		client.Select("name"),
+		client.WhereMap(map[string]interface{}{"$ge": map[string]interface{}{"age": 30}}),
+		client.OrderBy(client.DESC, "age"),
+		client.Limit(2),
+	)
+	assert.Nil(t, err)
+	result, err := store.FindQuery(query, &client.FindOptions{IncludeQueryPlan: true})
+	assert.Nil(t, err)
+	defer result.Close()
+
+	var names []interface{}
+	for result.Next() {
+		names = append(names, result.Stream().Value().(map[string]interface{})["name"])
+	}
+	assert.Nil(t, result.Err())
+	assert.Equal(t, []interface{}{"Cid", "Dan"}, names)
+	assert.True(t, strings.Contains(result.QueryPlan(), "DBDocumentStream"))
+	indexes, err := result.QueryPlanIndexes()
+	assert.Nil(t, err)
+	assert.Empty(t, indexes)
+	plan, err := result.ParsedQueryPlan()
+	assert.Nil(t, err)
+	assert.True(t, plan.IsFullTableScan())
+	assert.False(t, plan.UsesIndex("_id"))
+	assert.Equal(t, int64(4), plan.Roots[0].EstimatedRows)
+	assert.Equal(t, []string{"name"}, plan.Roots[0].Projection)
+
+	query, err = client.MakeQuery(client.Select("name"), client.ForbidIndex())
+	assert.Nil(t, err)
+	result, err = store.FindQuery(query, &client.FindOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, result.DocumentList(), 4)
+}
+
+func TestServer_Paginate(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	for _, doc := range []string{
+		`{"_id": "a", "age": 35, "name": "Ann"}`,
+		`{"_id": "b", "age": 20, "name": "Bob"}`,
+		`{"_id": "c", "age": 35, "name": "Cid"}`,
+		`{"_id": "d", "age": 41, "name": "Dan"}`,
+		`{"_id": "e", "age": 35, "name": "Eve"}`,
+	} {
+		assert.Nil(t, store.InsertString(doc))
+	}
+	query, err := client.MakeQuery(
+		client.Select("name"),
+		client.WhereMap(map[string]interface{}{"$ge": map[string]interface{}{"age": 30}}),
+		client.OrderBy(client.DESC, "age"),
+	)
+	assert.Nil(t, err)
+	paginator, err := store.Paginate(query, 2, "")
+	assert.Nil(t, err)
+	var pages [][]string
+	for paginator.Next() {
+		var ids []string
+		for _, doc := range paginator.Page() {
+			id, err := doc.GetIdString()
+			assert.Nil(t, err)
+			ids = append(ids, id)
+		}
+		pages = append(pages, ids)
+		if len(pages) == 1 {
+			// The next pages don't shift when documents before the cursor are inserted
+			assert.Nil(t, store.InsertString(`{"_id": "0", "age": 50, "name": "Zed"}`))
+		}
+	}
+	assert.Nil(t, paginator.Err())
+	assert.False(t, paginator.HasMore())
+	assert.Equal(t, [][]string{{"d", "a"}, {"c", "e"}}, pages)
+
+	paginator, err = store.Paginate(query, 2, "")
+	assert.Nil(t, err)
+	assert.True(t, paginator.Next())
+	assert.True(t, paginator.HasMore())
+	resumed, err := store.Paginate(query, 3, paginator.Cursor())
+	assert.Nil(t, err)
+	assert.True(t, resumed.Next())
+	assert.Len(t, resumed.Page(), 3)
+	assert.Equal(t, "Eve", resumed.Page()[2].AsMap()["name"])
+	assert.False(t, resumed.HasMore())
+	assert.False(t, resumed.Next())
+	assert.Nil(t, resumed.Err())
+}
+
+func TestServer_Changes(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	// Resume tokens of the server are positions in the changelog, so the stream starts from the first change
+	// regardless of when the server receives the request
+	changes, err := store.ChangesWithContext(&client.ChangesOptions{ResumeAfter: "0", IncludeDocuments: true}, ctx)
+	assert.Nil(t, err)
+	defer changes.Close()
+	assert.Nil(t, store.InsertString(`{"_id": "id0", "name": "Old"}`))
+	assert.True(t, changes.NextWithContext(ctx))
+	assert.Equal(t, client.ChangeInsert, changes.Event().Type)
+
+	assert.Nil(t, store.InsertString(`{"_id": "id1", "name": "John", "address": {"city": "Boston"}}`))
+	mutation, err := client.MakeDocumentMutation(client.Set("address.city", "Austin"))
+	assert.Nil(t, err)
+	assert.Nil(t, store.Update(client.BosiFromString("id1"), client.MosmFromStruct(mutation)))
+	_, err = store.DeleteByIdString("id0")
+	assert.Nil(t, err)
+
+	var events []*client.ChangeEvent
+	for len(events) < 3 && changes.NextWithContext(ctx) {
+		events = append(events, changes.Event())
+	}
+	assert.Nil(t, changes.Err())
+	assert.Len(t, events, 3)
+	assert.Equal(t, client.ChangeInsert, events[0].Type)
+	assert.Nil(t, events[0].Before)
+	assert.Equal(t, "John", events[0].After.AsMap()["name"])
+	assert.Equal(t, client.ChangeUpdate, events[1].Type)
+	assert.Equal(t, []string{"address.city"}, events[1].ChangedFields)
+	city, err := events[1].Before.GetString("address.city")
+	assert.Nil(t, err)
+	assert.Equal(t, "Boston", city)
+	assert.Equal(t, client.ChangeDelete, events[2].Type)
+	id, err := events[2].Id.GetIdString()
+	assert.Nil(t, err)
+	assert.Equal(t, "id0", id)
+	assert.Nil(t, events[2].After)
+
+	resumed, err := store.ChangesWithContext(&client.ChangesOptions{
+		ResumeAfter: events[0].ResumeToken,
+		FieldPaths:  []string{"address"},
+	}, ctx)
+	assert.Nil(t, err)
+	defer resumed.Close()
+	assert.True(t, resumed.NextWithContext(ctx))
+	assert.Equal(t, client.ChangeUpdate, resumed.Event().Type)
+	assert.Nil(t, resumed.Event().After)
+	assert.Equal(t, events[1].ResumeToken, resumed.ResumeToken())
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer waitCancel()
+	assert.False(t, resumed.NextWithContext(waitCtx))
+	assert.Equal(t, context.DeadlineExceeded, resumed.Err())
+
+	invalid, err := store.ChangesWithContext(&client.ChangesOptions{ResumeAfter: "invalid"}, ctx)
+	assert.Nil(t, err)
+	assert.False(t, invalid.NextWithContext(ctx))
+	assert.True(t, errors.Is(invalid.Err(), client.ErrInvalidArgument))
+}
+
+func TestServer_Telemetry(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	instruments, err := telemetry.MakeTelemetry(&telemetry.Options{
+		TracerProvider: tracerProvider,
+		MeterProvider:  meterProvider,
+	})
+	assert.Nil(t, err)
+	connection, err := client.NewConnection(context.Background(), server.ConnectionString(),
+		append(server.Options(), instruments.ConnectionOptions()...)...)
+	assert.Nil(t, err)
+	defer connection.Close()
+	store, err := connection.CreateStore("/test")
+	assert.Nil(t, err)
+	assert.Nil(t, store.InsertString(`{"_id": "a", "name": "Ann"}`))
+	assert.Nil(t, store.InsertString(`{"_id": "b", "name": "Bob"}`))
+	assert.NotNil(t, store.InsertString(`{"_id": "a", "name": "Ann"}`))
+	result, err := store.FindAll(&client.FindOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, result.DocumentList(), 2)
+	result.Close()
+
+	spans := exporter.GetSpans()
+	var names []string
+	for _, span := range spans {
+		names = append(names, span.Name)
+	}
+	assert.Equal(t, []string{
+		"com.mapr.data.db.MapRDbServer/Ping",
+		"com.mapr.data.db.MapRDbServer/CreateTable",
+		"com.mapr.data.db.MapRDbServer/TableExists",
+		"com.mapr.data.db.MapRDbServer/InsertOrReplace",
+		"com.mapr.data.db.MapRDbServer/InsertOrReplace",
+		"com.mapr.data.db.MapRDbServer/InsertOrReplace",
+		"com.mapr.data.db.MapRDbServer/Find",
+	}, names)
+	attributes := func(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+		values := map[attribute.Key]attribute.Value{}
+		for _, kv := range span.Attributes {
+			values[kv.Key] = kv.Value
+		}
+		return values
+	}
+	insert := attributes(spans[3])
+	assert.Equal(t, "/test", insert["maprdb.table_path"].AsString())
+	assert.Equal(t, "INSERT", insert["maprdb.insert_mode"].AsString())
+	assert.Equal(t, "InsertOrReplace", insert["rpc.method"].AsString())
+	assert.Equal(t, "NO_ERROR", insert["maprdb.error_code"].AsString())
+	duplicate := attributes(spans[5])
+	assert.Equal(t, "DOCUMENT_ALREADY_EXISTS", duplicate["maprdb.error_code"].AsString())
+	find := attributes(spans[6])
+	assert.Equal(t, "/test", find["maprdb.table_path"].AsString())
+	assert.Equal(t, int64(2), find["maprdb.document_count"].AsInt64())
+	assert.Equal(t, "NO_ERROR", find["maprdb.error_code"].AsString())
+
+	var metrics metricdata.ResourceMetrics
+	assert.Nil(t, reader.Collect(context.Background(), &metrics))
+	assert.Len(t, metrics.ScopeMetrics, 1)
+	var count uint64
+	for _, m := range metrics.ScopeMetrics[0].Metrics {
+		if m.Name == "maprdb.client.duration" {
+			for _, point := range m.Data.(metricdata.Histogram[float64]).DataPoints {
+				count += point.Count
+			}
+		}
+	}
+	assert.Equal(t, uint64(7), count)
+}
+
+func TestServer_Logger(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	buffer := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	connection, err := client.NewConnection(context.Background(), server.ConnectionString(),
+		append(server.Options(), client.WithLogger(logger))...)
+	assert.Nil(t, err)
+	defer connection.Close()
+	_, err = connection.CreateStore("/test")
+	assert.Nil(t, err)
+	_, err = connection.CreateStore("/test")
+	assert.NotNil(t, err)
+
+	output := buffer.String()
+	assert.Contains(t, output, "msg=connected target=bufnet")
+	assert.Contains(t, output, `msg="ping succeeded"`)
+	assert.Contains(t, output, `msg="call failed" method=/com.mapr.data.db.MapRDbServer/CreateTable table=/test `+
+		`error_code=TABLE_ALREADY_EXISTS`)
+	assert.NotContains(t, output, "bWFwcjptYXBy")
+}
+
+func TestServer_HealthCheck(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	var failing, dials int32
+	interceptor := func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if atomic.LoadInt32(&failing) != 0 {
+			return status.Error(codes.Unavailable, "gateway is down")
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		atomic.AddInt32(&dials, 1)
+		return server.Dialer()(ctx, address)
+	}
+	var mutex sync.Mutex
+	var states []client.ConnectionState
+	reconnecting := make(chan struct{}, 1)
+	connection, err := client.NewConnection(context.Background(), server.ConnectionString(),
+		append(server.Options(),
+			client.WithDialOptions(grpc.WithContextDialer(dialer)),
+			client.WithUnaryInterceptors(interceptor),
+			client.WithHealthCheck(&client.HealthCheckOptions{
+				Interval:           20 * time.Millisecond,
+				FailureThreshold:   2,
+				ReconnectThreshold: 3,
+				OnStateChange: func(previous, current client.ConnectionState) {
+					mutex.Lock()
+					defer mutex.Unlock()
+					states = append(states, current)
+					if current == client.ConnectionStateConnecting {
+						select {
+						case reconnecting <- struct{}{}:
+						default:
+						}
+					}
+				},
+			}),
+		)...)
+	assert.Nil(t, err)
+	assert.Equal(t, client.ConnectionStateReady, connection.State())
+	assert.Nil(t, connection.WaitForReady(context.Background()))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dials))
+
+	atomic.StoreInt32(&failing, 1)
+	<-reconnecting
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, connection.WaitForReady(ctx))
+	atomic.StoreInt32(&failing, 0)
+	assert.Nil(t, connection.WaitForReady(context.Background()))
+	exists, err := connection.IsStoreExists("/test")
+	assert.Nil(t, err)
+	assert.False(t, exists)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&dials))
+
+	connection.Close()
+	assert.Equal(t, client.ConnectionStateClosed, connection.State())
+	assert.Equal(t, client.ErrConnectionClosed, connection.WaitForReady(nil))
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, []client.ConnectionState{
+		client.ConnectionStateReady,
+		client.ConnectionStateUnhealthy,
+		client.ConnectionStateConnecting,
+	}, states[:3])
+	assert.Equal(t, []client.ConnectionState{
+		client.ConnectionStateReady,
+		client.ConnectionStateClosed,
+	}, states[len(states)-2:])
+}
+
+func TestServer_Gateways(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	var mutex sync.Mutex
+	calls := map[string]int{}
+	down := map[string]bool{}
+	interceptor := func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		mutex.Lock()
+		if strings.HasSuffix(method, "/TableExists") {
+			calls[cc.Target()]++
+		}
+		unavailable := down[cc.Target()]
+		mutex.Unlock()
+		if unavailable {
+			return status.Error(codes.Unavailable, "gateway is down")
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	resetCalls := func() map[string]int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		previous := calls
+		calls = map[string]int{}
+		return previous
+	}
+	connectionString := strings.Replace(server.ConnectionString(), "bufnet", "gw1:5678,gw2:5678", 1)
+	connection, err := client.NewConnection(context.Background(), connectionString,
+		append(server.Options(),
+			client.WithUnaryInterceptors(interceptor),
+			client.WithHealthCheck(&client.HealthCheckOptions{Interval: 10 * time.Millisecond}),
+		)...)
+	assert.Nil(t, err)
+	defer connection.Close()
+
+	resetCalls()
+	for i := 0; i < 4; i++ {
+		_, err = connection.IsStoreExists("/test")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, map[string]int{"gw1:5678": 2, "gw2:5678": 2}, resetCalls())
+
+	pinned := connection.Pin()
+	for i := 0; i < 4; i++ {
+		_, err = pinned.IsStoreExists("/test")
+		assert.Nil(t, err)
+	}
+	pinned.Close()
+	assert.Len(t, resetCalls(), 1)
+	assert.Equal(t, client.ConnectionStateReady, connection.State())
+
+	mutex.Lock()
+	down["gw1:5678"] = true
+	mutex.Unlock()
+	_, err = connection.IsStoreExists("/test")
+	assert.Nil(t, err)
+	_, err = connection.IsStoreExists("/test")
+	assert.Nil(t, err)
+	assert.LessOrEqual(t, resetCalls()["gw1:5678"], 1)
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, client.ConnectionStateReady, connection.State())
+	resetCalls()
+	for i := 0; i < 4; i++ {
+		_, err = connection.IsStoreExists("/test")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, map[string]int{"gw2:5678": 4}, resetCalls())
+
+	mutex.Lock()
+	down["gw1:5678"] = false
+	mutex.Unlock()
+	time.Sleep(30 * time.Millisecond)
+	resetCalls()
+	for i := 0; i < 4; i++ {
+		_, err = connection.IsStoreExists("/test")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, map[string]int{"gw1:5678": 2, "gw2:5678": 2}, resetCalls())
+}
+
+func TestServer_TableNotFound(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	connection, err := server.Connect()
+	assert.Nil(t, err)
+	defer connection.Close()
+
+	_, err = connection.GetStore("/missing")
+	assert.True(t, errors.Is(err, client.ErrTableNotFound))
+	assert.NotNil(t, connection.DeleteStore("/missing"))
+}
+
+func TestServer_Struct(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	type user struct {
+		Id   string   `ojai:"_id"`
+		Name string   `ojai:"name"`
+		Age  int      `ojai:"age"`
+		Tags []string `ojai:"tags,omitempty"`
+	}
+	assert.Nil(t, store.InsertStruct(user{Id: "id1", Name: "John", Age: 35, Tags: []string{"a"}}))
+	assert.NotNil(t, store.InsertStruct(user{Id: "id1"}))
+	assert.Nil(t, store.ReplaceStruct(&user{Id: "id1", Name: "Jane", Age: 36}))
+
+	var found user
+	exists, err := store.FindByIdInto(client.BosiFromString("id1"), &found)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, user{Id: "id1", Name: "Jane", Age: 36}, found)
+
+	exists, err = store.FindByIdInto(client.BosiFromString("id2"), &found)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
+
+func TestServer_BulkWrite(t *testing.T) {
+	server, connection, store := makeStore(t)
+	defer server.Close()
+	defer connection.Close()
+
+	assert.Nil(t, store.InsertString(`{"_id": "id1", "count": 1}`))
+	assert.Nil(t, store.InsertString(`{"_id": "id5"}`))
+	mutation, err := client.MakeDocumentMutation(client.IncrementInt("count", 1))
+	assert.Nil(t, err)
+	makeDoc := func(json string) *client.Document {
+		doc, err := client.MakeDocumentFromJson(json)
+		assert.Nil(t, err)
+		return doc
+	}
+	ops := []client.WriteOp{
+		client.InsertOp(makeDoc(`{"_id": "id2"}`)),
+		client.InsertOp(makeDoc(`{"_id": "id1"}`)),
+		client.ReplaceOp(makeDoc(`{"_id": "id3"}`)),
+		client.InsertOrReplaceOp(makeDoc(`{"_id": "id4"}`)),
+		client.UpdateOp(client.BosiFromString("id1"), client.MosmFromStruct(mutation)),
+		client.UpdateOp(client.BosiFromString("missing"), client.MosmFromStruct(mutation)),
+		client.DeleteOp(client.BosiFromString("id5")),
+		client.InsertOp(makeDoc(`{"name": "no id"}`)),
+	}
+	results, err := store.BulkWrite(ops, &client.BulkWriteOptions{
+		Concurrency:       3,
+		RequestsPerSecond: 1000,
+	})
+	assert.Nil(t, err)
+	var codes []client.ErrorCode
+	for _, result := range results {
+		codes = append(codes, result.Code)
+		assert.Equal(t, result.Code != client.ErrorCode_NO_ERROR, result.Err != nil)
+	}
+	assert.Equal(t, []client.ErrorCode{
+		client.ErrorCode_NO_ERROR,
+		client.ErrorCode_DOCUMENT_ALREADY_EXISTS,
+		client.ErrorCode_DOCUMENT_NOT_FOUND,
+		client.ErrorCode_NO_ERROR,
+		client.ErrorCode_NO_ERROR,
+		client.ErrorCode_DOCUMENT_NOT_FOUND,
+		client.ErrorCode_NO_ERROR,
+		client.ErrorCode_INVALID_ARGUMENT,
+	}, codes)
+
+	doc, err := store.FindByIdString("id1")
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2, doc.AsMap()["count"])
+	doc, err = store.FindByIdString("id4")
+	assert.Nil(t, err)
+	assert.Equal(t, "id4", doc.AsMap()["_id"])
+	doc, err = store.FindByIdString("id5")
+	assert.Nil(t, err)
+	assert.Empty(t, doc.AsMap())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err = store.BulkWriteWithContext(ops[:2], nil, ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Len(t, results, 2)
+	_, err = store.BulkWrite(ops, &client.BulkWriteOptions{Concurrency: -1})
+	assert.NotNil(t, err)
+}
+
+func TestTable_SortedKeys(t *testing.T) {
+	table := newTable()
+	for _, id := range []interface{}{[]byte("a"), "b", []byte("c"), "d"} {
+		key, err := documentKey(map[string]interface{}{"_id": id})
+		assert.Nil(t, err)
+		table.documents[key] = map[string]interface{}{"_id": id}
+	}
+	var ids []interface{}
+	for _, key := range table.sortedKeys() {
+		ids = append(ids, table.documents[key]["_id"])
+	}
+	assert.Equal(t, []interface{}{"b", "d", []byte("a"), []byte("c")}, ids)
+}
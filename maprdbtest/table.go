@@ -0,0 +1,125 @@
+package maprdbtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	client "github.com/mapr/maprdb-go-client"
+)
+
+// table is an in-memory store of documents indexed by _id with the changelog of all writes
+type table struct {
+	documents map[string]map[string]interface{}
+	changes   []*change
+}
+
+func newTable() *table {
+	return &table{documents: make(map[string]map[string]interface{})}
+}
+
+// lookup decodes document with _id field and returns stored document with the same _id or nil
+func (table *table) lookup(jsonDocument string) (map[string]interface{}, string, error) {
+	idDocument, err := decodeMap(jsonDocument)
+	if err != nil {
+		return nil, "", err
+	}
+	key, err := documentKey(idDocument)
+	if err != nil {
+		return nil, "", err
+	}
+	return table.documents[key], key, nil
+}
+
+// sortedKeys returns keys of all documents in _id order.
+// String ids are ordered before binary ids like in MapR-DB.
+func (table *table) sortedKeys() []string {
+	keys := make([]string, 0, len(table.documents))
+	for key := range table.documents {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// documentKey returns key of the document in the table built from _id field,
+// prefixes of the keys order string ids before binary ids
+func documentKey(document map[string]interface{}) (string, error) {
+	switch id := document["_id"].(type) {
+	case string:
+		if len(id) == 0 {
+			return "", newError(client.ErrorCode_INVALID_ARGUMENT, "_id can't be empty")
+		}
+		return "0" + id, nil
+	case []byte:
+		if len(id) == 0 {
+			return "", newError(client.ErrorCode_INVALID_ARGUMENT, "_id can't be empty")
+		}
+		return "1" + string(id), nil
+	case nil:
+		return "", newError(client.ErrorCode_INVALID_ARGUMENT, "the document must contain the _id field")
+	default:
+		return "", newError(client.ErrorCode_INVALID_ARGUMENT, "unsupported _id type %T", id)
+	}
+}
+
+// decodeMap decodes OJAI JSON into map with client types
+func decodeMap(jsonDocument string) (map[string]interface{}, error) {
+	doc, err := client.MakeDocumentFromJson(jsonDocument)
+	if err != nil {
+		return nil, newError(client.ErrorCode_DECODING_ERROR, "couldn't decode %v: %v", jsonDocument, err)
+	}
+	return doc.AsMap(), nil
+}
+
+// encodeMap encodes map with client types into OJAI JSON
+func encodeMap(document map[string]interface{}) (string, error) {
+	ser, err := json.Marshal(client.MakeDocumentFromMap(document))
+	if err != nil {
+		return "", newError(client.ErrorCode_ENCODING_ERROR, "couldn't encode document: %v", err)
+	}
+	return string(ser), nil
+}
+
+// copyValue returns deep copy of maps and slices which are stored in the table
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		cp := make(map[string]interface{}, len(v))
+		for key, element := range v {
+			cp[key] = copyValue(element)
+		}
+		return cp
+	case []interface{}:
+		cp := make([]interface{}, len(v))
+		for index, element := range v {
+			cp[index] = copyValue(element)
+		}
+		return cp
+	default:
+		return v
+	}
+}
+
+// project returns copy of the document which contains only given field paths and _id
+func project(document map[string]interface{}, fieldPaths []string) map[string]interface{} {
+	if len(fieldPaths) == 0 {
+		return copyValue(document).(map[string]interface{})
+	}
+	result := map[string]interface{}{"_id": document["_id"]}
+	for _, fieldPath := range fieldPaths {
+		path, err := parsePath(fieldPath)
+		if err != nil {
+			continue
+		}
+		if value, ok := getPath(document, path); ok {
+			setPath(result, path, copyValue(value))
+		}
+	}
+	return result
+}
+
+// describe returns short description of the value for error messages
+func describe(value interface{}) string {
+	return fmt.Sprintf("%v (%T)", value, value)
+}
@@ -6,25 +6,33 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
-	"regexp"
 	"strconv"
+	"strings"
 )
 
-var re = regexp.MustCompile(`^"|['"](\w+(?:\.\w+)*)['"]|(\w+)`)
-var arrRgx = regexp.MustCompile(`\[(\d*?)\]`)
-
 // Set of OJAI keys
 var ojaiKeys = map[string]interface{}{
 	"$numberLong":  "",
+	"$numberInt":   "",
+	"$numberShort": "",
+	"$numberByte":  "",
 	"$numberFloat": "",
+	"$decimal":     "",
+	"$interval":    "",
 	"$binary":      "",
 	"$time":        "",
 	"$date":        "",
 	"$dateDay":     ""}
 
+// Document OJAI document, field paths of its methods are parsed by MakeFieldPath.
+// Chained Set methods record the first error of invalid field path, it's returned by Err and MarshalJSON,
+// so the document isn't sent to the store. Functional options of MakeDocument return errors immediately.
 type Document struct {
 	documentMap map[string]interface{}
+	fieldOrder  *fieldOrder
+	err         error
 }
 
 // Type for Document functional options
@@ -46,7 +54,7 @@ func MakeDocument(operations ...DocumentOperations) (*Document, error) {
 
 // MakeDocumentFromMap function creates and returns new Document from given map[string]interface{}
 func MakeDocumentFromMap(initialData map[string]interface{}) *Document {
-	return &Document{initialData}
+	return &Document{documentMap: initialData}
 }
 
 // MakeDocumentFromJson function creates and returns new Document from given JSON string
@@ -65,7 +73,9 @@ func SetIdString(id string) DocumentOperations {
 		if len(id) == 0 {
 			return nil, errors.New("_id field can't be empty")
 		}
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue("_id", id)).(map[string]interface{})
+		if err := doc.set("_id", id); err != nil {
+			return nil, err
+		}
 		return doc, nil
 	}
 }
@@ -75,8 +85,7 @@ func (doc *Document) SetIdString(id string) error {
 	if len(id) == 0 {
 		return errors.New("_id field can't be empty")
 	}
-	doc.documentMap = mergeMaps(doc.documentMap, doc.newValue("_id", id)).(map[string]interface{})
-	return nil
+	return doc.set("_id", id)
 }
 
 // SetIdBinary functional option which sets not empty _id byte field in theDocument
@@ -85,7 +94,9 @@ func SetIdBinary(id []byte) DocumentOperations {
 		if len(id) == 0 {
 			return nil, errors.New("_id field can't be empty")
 		}
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue("_id", id)).(map[string]interface{})
+		if err := doc.set("_id", id); err != nil {
+			return nil, err
+		}
 		return doc, nil
 	}
 }
@@ -95,23 +106,19 @@ func (doc *Document) SetIdBinary(id []byte) error {
 	if len(id) == 0 {
 		return errors.New("_id field can't be empty")
 	}
-	doc.documentMap = mergeMaps(doc.documentMap, doc.newValue("_id", id)).(map[string]interface{})
-	return nil
+	return doc.set("_id", id)
 }
 
 // SetString method sets string value to given field path
 func (doc *Document) SetString(fieldPath string, value string) *Document {
-	doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetString method sets string value to given field path
 func SetString(fieldPath string, value string) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -119,21 +126,74 @@ func SetString(fieldPath string, value string) DocumentOperations {
 
 // SetInt method sets int value to given field path
 func (doc *Document) SetInt(fieldPath string, value int) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetInt method sets int value to given field path
 func SetInt(fieldPath string, value int) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	}
+}
+
+// SetInt8 method sets int8 (OJAI byte) value to given field path
+func (doc *Document) SetInt8(fieldPath string, value int8) *Document {
+	return doc.setChained(fieldPath, value)
+}
+
+// SetInt8 method sets int8 (OJAI byte) value to given field path
+func SetInt8(fieldPath string, value int8) DocumentOperations {
+	return func(doc *Document) (*Document, error) {
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	}
+}
+
+// SetInt16 method sets int16 (OJAI short) value to given field path
+func (doc *Document) SetInt16(fieldPath string, value int16) *Document {
+	return doc.setChained(fieldPath, value)
+}
+
+// SetInt16 method sets int16 (OJAI short) value to given field path
+func SetInt16(fieldPath string, value int16) DocumentOperations {
+	return func(doc *Document) (*Document, error) {
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	}
+}
+
+// SetInt32 method sets int32 (OJAI int) value to given field path
+func (doc *Document) SetInt32(fieldPath string, value int32) *Document {
+	return doc.setChained(fieldPath, value)
+}
+
+// SetInt32 method sets int32 (OJAI int) value to given field path
+func SetInt32(fieldPath string, value int32) DocumentOperations {
+	return func(doc *Document) (*Document, error) {
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	}
+}
+
+// SetInt64 method sets int64 (OJAI long) value to given field path
+func (doc *Document) SetInt64(fieldPath string, value int64) *Document {
+	return doc.setChained(fieldPath, value)
+}
+
+// SetInt64 method sets int64 (OJAI long) value to given field path
+func SetInt64(fieldPath string, value int64) DocumentOperations {
+	return func(doc *Document) (*Document, error) {
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -141,21 +201,14 @@ func SetInt(fieldPath string, value int) DocumentOperations {
 
 // SetBool method sets bool value to given field path
 func (doc *Document) SetBool(fieldPath string, value bool) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetBool method sets bool value to given field path
 func SetBool(fieldPath string, value bool) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -163,21 +216,14 @@ func SetBool(fieldPath string, value bool) DocumentOperations {
 
 // SetFloat32 method sets float32 value to given field path
 func (doc *Document) SetFloat32(fieldPath string, value float32) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetFloat32 method sets float32 value to given field path
 func SetFloat32(fieldPath string, value float32) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -185,21 +231,14 @@ func SetFloat32(fieldPath string, value float32) DocumentOperations {
 
 // SetFloat64 method sets float64 value to given field path
 func (doc *Document) SetFloat64(fieldPath string, value float64) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetFloat64 method sets float64 value to given field path
 func SetFloat64(fieldPath string, value float64) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -207,21 +246,14 @@ func SetFloat64(fieldPath string, value float64) DocumentOperations {
 
 // SetSlice method sets list[]interface{}value to given field path
 func (doc *Document) SetSlice(fieldPath string, value []interface{}) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetSlice method sets list[]interface{}value to given field path
 func SetSlice(fieldPath string, value []interface{}) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -229,21 +261,14 @@ func SetSlice(fieldPath string, value []interface{}) DocumentOperations {
 
 // SetNil method sets nil value to given field path
 func (doc *Document) SetNil(fieldPath string) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, nil)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, nil)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, nil)
 }
 
 // SetNil method sets nil value to given field path
 func SetNil(fieldPath string) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, nil)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, nil)).(map[string]interface{})
+		if err := doc.set(fieldPath, nil); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -251,21 +276,14 @@ func SetNil(fieldPath string) DocumentOperations {
 
 // SetByte method sets []byte value to given field path
 func (doc *Document) SetByte(fieldPath string, value []byte) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetByte method sets []byte value to given field path
 func SetByte(fieldPath string, value []byte) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -276,12 +294,7 @@ func (doc *Document) SetMap(fieldPath string, value map[string]interface{}) *Doc
 	//if doc.IsPathExists(fieldPath) {
 	//	doc.Delete(fieldPath)
 	//}
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetMap method sets map[string]interface{} value to given field path
@@ -290,10 +303,8 @@ func SetMap(fieldPath string, value map[string]interface{}) DocumentOperations {
 		//if doc.IsPathExists(fieldPath) {
 		//	doc.Delete(fieldPath)
 		//}
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -324,83 +335,114 @@ func (doc *Document) IsPathExists(fieldPath string) bool {
 	return res != nil
 }
 
+// Internal set method sets value to given field path, array indexes of the path are supported.
+// Fields which didn't exist before are placed after the existing ones when the document is encoded.
+func (doc *Document) set(fieldPath string, value interface{}) error {
+	path, err := MakeFieldPath(fieldPath)
+	if err != nil {
+		return err
+	}
+	if path.hasAnyIndex() {
+		return fmt.Errorf("value can't be set to field path %v with [] index", fieldPath)
+	}
+	if doc.fieldOrder == nil {
+		doc.fieldOrder = &fieldOrder{}
+	}
+	doc.fieldOrder.record(doc.documentMap, path.segments)
+	for _, segment := range path.segments {
+		if segment.isIndex {
+			doc.setArrayValue(path.segments, value)
+			return nil
+		}
+	}
+	doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(path.segments, value)).(map[string]interface{})
+	return nil
+}
+
+// setChained method sets value like set and records its error, so it's reported by Err
+func (doc *Document) setChained(fieldPath string, value interface{}) *Document {
+	if err := doc.set(fieldPath, value); err != nil && doc.err == nil {
+		doc.err = err
+	}
+	return doc
+}
+
+// Err method returns the first error of chained Set methods, e.g. invalid field path
+func (doc *Document) Err() error {
+	return doc.err
+}
+
 // Internal get method gets value from given field path or returns nil
 func (doc *Document) get(fieldPath string) (interface{}, error) {
-	tempMap := doc.documentMap
-	for key, value := range doc.documentMap {
-		tempMap[key] = value
+	path, err := MakeFieldPath(fieldPath)
+	if err != nil {
+		return nil, err
 	}
-	parsedPath := doc.parseFieldPath(fieldPath)
-	for i := 0; i < len(parsedPath); i++ {
-		//for index, element := range parsedPath {
-		if val, ok := tempMap[parsedPath[i]]; ok {
-			if mv, ok := val.(map[string]interface{}); ok {
-				tempMap = mv
-			} else if mv, ok := val.([]interface{}); ok {
-				if i == len(parsedPath)-1 {
-					return mv, nil
-				}
-				i++
-				index, err := strconv.Atoi(parsedPath[i])
-				if err != nil {
-					return nil, err
-				}
-				value := mv[index]
-				if i == len(parsedPath)-1 {
-					return value, nil
-				} else {
-					if mv, ok := value.(map[string]interface{}); ok {
-						tempMap = mv
-					} else {
-						return nil, fmt.Errorf("invalid field path")
-					}
-				}
-			} else {
-				if i == len(parsedPath)-1 {
-					return val, nil
-				} else {
-					return nil, nil
-				}
+	if path.hasAnyIndex() {
+		return nil, fmt.Errorf("value can't be read from field path %v with [] index", fieldPath)
+	}
+	return getSegmentValue(doc.documentMap, path.segments), nil
+}
+
+// getSegmentValue returns value at the path inside the container or nil if it doesn't exist
+func getSegmentValue(container interface{}, path []FieldSegment) interface{} {
+	for _, segment := range path {
+		if segment.isIndex {
+			array, ok := container.([]interface{})
+			if !ok || segment.index >= len(array) {
+				return nil
 			}
+			container = array[segment.index]
 		} else {
-			return nil, nil
+			m, ok := container.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			container = m[segment.name]
 		}
 	}
-	return tempMap, nil
+	return container
 }
 
 // The Delete function deletes element from given fieldPath if it exists in document.
+// Element of an array is removed and the following elements are shifted, invalid field path is ignored.
 func (doc *Document) Delete(fieldPath string) *Document {
-	if doc.IsPathExists(fieldPath) {
-		tempMap := doc.documentMap
-		parsedPath := doc.parseFieldPath(fieldPath)
-		if len(parsedPath) == 1 {
-			delete(doc.documentMap, parsedPath[len(parsedPath)-1])
-		} else {
-			for i := 0; i <= len(parsedPath)-2; i++ {
-				if mv, ok := tempMap[parsedPath[i]].(map[string]interface{}); ok {
-					tempMap = mv
-				}
-			}
-			delete(tempMap, parsedPath[len(parsedPath)-1])
+	path, err := MakeFieldPath(fieldPath)
+	if err != nil || path.hasAnyIndex() {
+		return doc
+	}
+	parentPath, last := path.segments[:len(path.segments)-1], path.segments[len(path.segments)-1]
+	switch parent := getSegmentValue(doc.documentMap, parentPath).(type) {
+	case map[string]interface{}:
+		if _, ok := parent[last.name]; last.isIndex || !ok {
+			return doc
+		}
+		delete(parent, last.name)
+	case []interface{}:
+		if !last.isIndex || last.index >= len(parent) {
+			return doc
 		}
+		doc.setArrayValue(parentPath, append(parent[:last.index:last.index], parent[last.index+1:]...))
+	default:
+		return doc
 	}
+	doc.fieldOrder.remove(path.segments)
 	return doc
 }
 
 // Method cleans whole document
 func (doc *Document) Clean() *Document {
 	doc.documentMap = make(map[string]interface{})
+	doc.fieldOrder = nil
+	doc.err = nil
 	return doc
 }
 
 // SetTime method sets OTIme value to given field path
 func SetTime(fieldPath string, value *OTime) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -408,21 +450,14 @@ func SetTime(fieldPath string, value *OTime) DocumentOperations {
 
 // SetTime method sets OTIme value to given field path
 func (doc *Document) SetTime(fieldPath string, value *OTime) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetDate method sets ODate value to given field path
 func SetDate(fieldPath string, value *ODate) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -430,21 +465,14 @@ func SetDate(fieldPath string, value *ODate) DocumentOperations {
 
 // SetDate method sets ODate value to given field path
 func (doc *Document) SetDate(fieldPath string, value *ODate) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
-	}
-	return doc
+	return doc.setChained(fieldPath, value)
 }
 
 // SetTimestamp method sets OTimestamp value to given field path
 func SetTimestamp(fieldPath string, value *OTimestamp) DocumentOperations {
 	return func(doc *Document) (*Document, error) {
-		if arrRgx.MatchString(fieldPath) {
-			doc.setArrayValue(fieldPath, value)
-		} else {
-			doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
 		}
 		return doc, nil
 	}
@@ -452,12 +480,37 @@ func SetTimestamp(fieldPath string, value *OTimestamp) DocumentOperations {
 
 // SetTimestamp method sets OTimestamp value to given field path
 func (doc *Document) SetTimestamp(fieldPath string, value *OTimestamp) *Document {
-	if arrRgx.MatchString(fieldPath) {
-		doc.setArrayValue(fieldPath, value)
-	} else {
-		doc.documentMap = mergeMaps(doc.documentMap, doc.newValue(fieldPath, value)).(map[string]interface{})
+	return doc.setChained(fieldPath, value)
+}
+
+// SetDecimal method sets Decimal value to given field path
+func (doc *Document) SetDecimal(fieldPath string, value *Decimal) *Document {
+	return doc.setChained(fieldPath, value)
+}
+
+// SetDecimal method sets Decimal value to given field path
+func SetDecimal(fieldPath string, value *Decimal) DocumentOperations {
+	return func(doc *Document) (*Document, error) {
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	}
+}
+
+// SetInterval method sets OInterval value to given field path
+func (doc *Document) SetInterval(fieldPath string, value *OInterval) *Document {
+	return doc.setChained(fieldPath, value)
+}
+
+// SetInterval method sets OInterval value to given field path
+func SetInterval(fieldPath string, value *OInterval) DocumentOperations {
+	return func(doc *Document) (*Document, error) {
+		if err := doc.set(fieldPath, value); err != nil {
+			return nil, err
+		}
+		return doc, nil
 	}
-	return doc
 }
 
 // Method check is _id key exists in the document map and returns true or false accordingly
@@ -492,15 +545,65 @@ func (doc *Document) GetString(fieldPath string) (string, error) {
 }
 
 // Method returns int value from given path or int zero value.
+// Integers of all sizes are returned, e.g. OJAI int and short, error is returned if the integer overflows int.
 func (doc *Document) GetInt(fieldPath string) (int, error) {
+	value, err := doc.getInteger(fieldPath, strconv.IntSize)
+	return int(value), err
+}
+
+// Method returns int8 value from given path or int8 zero value, error is returned if the integer overflows int8.
+func (doc *Document) GetInt8(fieldPath string) (int8, error) {
+	value, err := doc.getInteger(fieldPath, 8)
+	return int8(value), err
+}
+
+// Method returns int16 value from given path or int16 zero value, error is returned if the integer overflows int16.
+func (doc *Document) GetInt16(fieldPath string) (int16, error) {
+	value, err := doc.getInteger(fieldPath, 16)
+	return int16(value), err
+}
+
+// Method returns int32 value from given path or int32 zero value, error is returned if the integer overflows int32.
+func (doc *Document) GetInt32(fieldPath string) (int32, error) {
+	value, err := doc.getInteger(fieldPath, 32)
+	return int32(value), err
+}
+
+// Method returns int64 value from given path or int64 zero value, error is returned if the integer overflows int64.
+func (doc *Document) GetInt64(fieldPath string) (int64, error) {
+	return doc.getInteger(fieldPath, 64)
+}
+
+// Internal method returns integer of any size from given path, error is returned if it overflows bitSize
+func (doc *Document) getInteger(fieldPath string, bitSize int) (int64, error) {
 	value, err := doc.get(fieldPath)
 	if err != nil {
 		return 0, err
 	}
-	if res, ok := value.(int); ok {
-		return res, nil
+	var res int64
+	switch v := value.(type) {
+	case int:
+		res = int64(v)
+	case int8:
+		res = int64(v)
+	case int16:
+		res = int64(v)
+	case int32:
+		res = int64(v)
+	case int64:
+		res = v
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("value %v of field %v overflows int%v", v, fieldPath, bitSize)
+		}
+		res = int64(v)
+	default:
+		return 0, nil
 	}
-	return 0, nil
+	if bitSize < 64 && (res < -1<<(bitSize-1) || res >= 1<<(bitSize-1)) {
+		return 0, fmt.Errorf("value %v of field %v overflows int%v", res, fieldPath, bitSize)
+	}
+	return res, nil
 }
 
 // Method returns true if value for given path is nil or path doesn't exists.
@@ -512,14 +615,15 @@ func (doc *Document) IsNil(fieldPath string) (bool, error) {
 	return value == nil, nil
 }
 
-// Method returns float value from given path or string float value.
+// Method returns float value from given path or float zero value,
+// OJAI float and integer values are converted to float64.
 func (doc *Document) GetFloat64(fieldPath string) (float64, error) {
 	value, err := doc.get(fieldPath)
 	if err != nil {
 		return 0, err
 	}
-	if res, ok := value.(float64); ok {
-		return res, nil
+	if res, ok := toNumber(value); ok {
+		return res.f, nil
 	}
 	return 0, nil
 }
@@ -620,6 +724,30 @@ func (doc *Document) GetTimestamp(fieldPath string) (OTimestamp, error) {
 	return OTimestamp{}, nil
 }
 
+// Method returns Decimal value from given path otherwise nil
+func (doc *Document) GetDecimal(fieldPath string) (*Decimal, error) {
+	value, err := doc.get(fieldPath)
+	if err != nil {
+		return nil, err
+	}
+	if mv, ok := value.(*Decimal); ok {
+		return mv, nil
+	}
+	return nil, nil
+}
+
+// Method returns OInterval value from given path otherwise nil
+func (doc *Document) GetInterval(fieldPath string) (*OInterval, error) {
+	value, err := doc.get(fieldPath)
+	if err != nil {
+		return nil, err
+	}
+	if mv, ok := value.(*OInterval); ok {
+		return mv, nil
+	}
+	return nil, nil
+}
+
 // Method returns document content as map[string]interface{}
 func (doc *Document) AsMap() map[string]interface{} {
 	return doc.documentMap
@@ -634,9 +762,10 @@ func (doc *Document) AsString() string {
 	return buffer.String()
 }
 
-// Method returns document content as JSON string
-func (doc *Document) AsJsonString() string {
-	jsonString, _ := json.Marshal(doc.documentMap)
+// Method returns document content as JSON string without OJAI type tags.
+// Fields are written in the order they were set or parsed, fields of unknown order follow them sorted by name.
+func (doc *Document) AsJsonString(options ...JsonOption) string {
+	jsonString, _ := encodeJson(doc.documentMap, doc.fieldOrder, options)
 	return string(jsonString)
 }
 
@@ -703,6 +832,9 @@ func (doc *Document) parseMap(value map[string]interface{}) (interface{}, error)
 func (doc *Document) parseArray(value []interface{}) ([]interface{}, error) {
 	for index, element := range value {
 		vt := reflect.TypeOf(element)
+		if vt == nil {
+			continue
+		}
 		switch vt.Kind() {
 		case reflect.Map:
 			if mv, ok := element.(map[string]interface{}); ok {
@@ -736,26 +868,45 @@ func (doc *Document) parseArray(value []interface{}) ([]interface{}, error) {
 }
 
 // Unmarshaler interface implementation
+// Numbers are decoded as json.Number, so integers are converted into Go types without loss of precision.
 func (doc *Document) UnmarshalJSON(b []byte) error {
-	var docMap map[string]interface{}
-	err := json.Unmarshal(b, &docMap)
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	value, order, err := decodeOrdered(decoder)
 	if err != nil {
 		return err
 	}
+	docMap, ok := value.(map[string]interface{})
+	if !ok && value != nil {
+		return errors.New("document must be JSON object")
+	}
 	err = doc.responseParser(docMap)
 	if err != nil {
 		return err
 	}
+	doc.fieldOrder = order
 	return nil
 }
 
 // method converts types to ojai format
 func ojaiTypeConversion(value interface{}) interface{} {
 	switch v := value.(type) {
-	case int, int64, int32:
+	case int, int64:
 		return map[string]interface{}{"$numberLong": v}
-	case float64, float32:
+	case int32:
+		return map[string]interface{}{"$numberInt": v}
+	case int16:
+		return map[string]interface{}{"$numberShort": v}
+	case int8:
+		return map[string]interface{}{"$numberByte": v}
+	case float32:
 		return map[string]interface{}{"$numberFloat": v}
+	case float64:
+		return formatDouble(v)
+	case *Decimal:
+		return map[string]interface{}{"$decimal": v.String()}
+	case *OInterval:
+		return map[string]interface{}{"$interval": v.Milliseconds()}
 	case []byte:
 		return map[string]interface{}{"$binary": b64.StdEncoding.EncodeToString(v)}
 	case *OTime:
@@ -771,7 +922,22 @@ func ojaiTypeConversion(value interface{}) interface{} {
 	}
 }
 
+// formatDouble returns float64 as JSON number which always has fraction or exponent,
+// so OJAI double isn't confused with integer when it's parsed back
+func formatDouble(value float64) json.Number {
+	formatted := strconv.FormatFloat(value, 'g', -1, 64)
+	if !strings.ContainsAny(formatted, ".eEnN") {
+		formatted += ".0"
+	}
+	return json.Number(formatted)
+}
+
 func (doc *Document) ojaiTypeTranslator(key string, value interface{}) (interface{}, error) {
+	if number, ok := value.(json.Number); ok {
+		if _, ok := ojaiKeys[key]; !ok {
+			return parseNumber(number)
+		}
+	}
 	if len(key) == 0 {
 		return value, nil
 	} else {
@@ -779,15 +945,73 @@ func (doc *Document) ojaiTypeTranslator(key string, value interface{}) (interfac
 	}
 }
 
+// parseNumber converts plain JSON number into int64 or uint64 if it's an integer which fits into them
+// and into float64 otherwise
+func parseNumber(number json.Number) (interface{}, error) {
+	literal := number.String()
+	if !strings.ContainsAny(literal, ".eE") {
+		if res, err := strconv.ParseInt(literal, 10, 64); err == nil {
+			return res, nil
+		}
+		if res, err := strconv.ParseUint(literal, 10, 64); err == nil {
+			return res, nil
+		}
+	}
+	res, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return nil, fmt.Errorf("number %v is out of range of float64", literal)
+	}
+	return res, nil
+}
+
 // Internal method which converts given OJAI type to corresponding Golang type
 func (doc *Document) parseOJAIValueString(key string, value interface{}) (interface{}, error) {
 	switch key {
 	case "$numberLong":
-		if mv, ok := value.(float64); ok {
-			return int(mv), nil
+		res, err := parseInteger(key, value, strconv.IntSize)
+		if err != nil {
+			return nil, err
+		}
+		return int(res), nil
+	case "$numberInt":
+		res, err := parseInteger(key, value, 32)
+		if err != nil {
+			return nil, err
+		}
+		return int32(res), nil
+	case "$numberShort":
+		res, err := parseInteger(key, value, 16)
+		if err != nil {
+			return nil, err
+		}
+		return int16(res), nil
+	case "$numberByte":
+		res, err := parseInteger(key, value, 8)
+		if err != nil {
+			return nil, err
 		}
+		return int8(res), nil
 	case "$numberFloat":
-		return value, nil
+		if mv, ok := value.(json.Number); ok {
+			res, err := strconv.ParseFloat(mv.String(), 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %v of %v", value, key)
+			}
+			return float32(res), nil
+		}
+	case "$decimal":
+		switch mv := value.(type) {
+		case string:
+			return MakeDecimalFromString(mv)
+		case json.Number:
+			return MakeDecimalFromString(mv.String())
+		}
+	case "$interval":
+		res, err := parseInteger(key, value, 64)
+		if err != nil {
+			return nil, err
+		}
+		return MakeOIntervalFromMillis(res), nil
 	case "$binary":
 		if mv, ok := value.(string); ok {
 			val, _ := b64.StdEncoding.DecodeString(mv)
@@ -812,25 +1036,30 @@ func (doc *Document) parseOJAIValueString(key string, value interface{}) (interf
 	return nil, fmt.Errorf("can't parse given key-value pair. unexpected value type")
 }
 
+// parseInteger parses value of OJAI integer type given as JSON number or string,
+// error is returned if it isn't an integer or overflows bitSize
+func parseInteger(key string, value interface{}, bitSize int) (int64, error) {
+	var literal string
+	switch mv := value.(type) {
+	case json.Number:
+		literal = mv.String()
+	case string:
+		literal = mv
+	}
+	res, err := strconv.ParseInt(literal, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %v of %v", value, key)
+	}
+	return res, nil
+}
+
 // Implementation of Marshaler interface for JSON encoding.
+// Encoding is canonical, fields are ordered like in AsJsonString, so equal documents are encoded into equal bytes.
 func (doc *Document) MarshalJSON() ([]byte, error) {
-	buffer := bytes.NewBufferString("{")
-	count := 0
-	serMap := doc.convertDocumentMap()
-	length := len(serMap)
-	for key, value := range serMap {
-		jsonValue, err := json.Marshal(value)
-		if err != nil {
-			return nil, err
-		}
-		buffer.WriteString(fmt.Sprintf("\"%s\":%s", key, jsonValue))
-		count++
-		if count < length {
-			buffer.WriteString(",")
-		}
+	if doc.err != nil {
+		return nil, doc.err
 	}
-	buffer.WriteString("}")
-	return buffer.Bytes(), nil
+	return encodeJson(doc.convertDocumentMap(), doc.fieldOrder, nil)
 }
 
 // Util method convert Document map to new formatted OJAI format map
@@ -936,6 +1165,9 @@ func throughMap(docMap map[string]interface{}) (map[string]interface{}, error) {
 func throughArray(arr []interface{}) ([]interface{}, error) {
 	for index, element := range arr {
 		vt := reflect.TypeOf(element)
+		if vt == nil {
+			continue
+		}
 		switch vt.Kind() {
 		case reflect.Map:
 			if mv, ok := element.(map[string]interface{}); ok {
@@ -964,67 +1196,40 @@ func throughArray(arr []interface{}) ([]interface{}, error) {
 	return arr, nil
 }
 
-// Internal method responsible for the case when fieldPath contains array indexes
-func (doc *Document) setArrayValue(fieldPath string, value interface{}) {
-	path := doc.parseFieldPath(fieldPath)
-	tempStorageMap := doc.documentMap
-	var tempStorageArray []interface{}
-	for i := 0; i < len(path); i++ {
-		fieldName := path[i]
-		data := tempStorageMap[fieldName]
-		if mv, ok := data.([]interface{}); ok {
-			tempStorageArray = mv
-			i++
-			index, _ := strconv.Atoi(path[i])
-			if i == len(path)-1 {
-				tempStorageMap[fieldName] = doc.setToArray(tempStorageArray, index, value)
-			} else {
-				tempStorageMap = tempStorageArray[index].(map[string]interface{})
-			}
-		} else if mv, ok := data.(map[string]interface{}); ok {
-			tempStorageMap = mv
-		} else {
-			tempStorageMap[fieldName] = value
-		}
-		//}
-	}
+// Internal method responsible for the case when field path contains array indexes,
+// missing maps and arrays are created and arrays are extended with nil elements up to the index
+func (doc *Document) setArrayValue(path []FieldSegment, value interface{}) {
+	doc.documentMap = setSegmentValue(doc.documentMap, path, value).(map[string]interface{})
 }
 
-// Extend array if index is greater then array size, otherwise just set the data at the given index
-func (doc *Document) setToArray(array []interface{}, index int, data interface{}) []interface{} {
-	if len(array) <= index {
-		for len(array) <= index {
+// setSegmentValue sets value at the path inside the container and returns the container,
+// container is replaced with a new map or array if it has other type
+func setSegmentValue(container interface{}, path []FieldSegment, value interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	segment := path[0]
+	if segment.isIndex {
+		array, _ := container.([]interface{})
+		for len(array) <= segment.index {
 			array = append(array, nil)
 		}
+		array[segment.index] = setSegmentValue(array[segment.index], path[1:], value)
+		return array
 	}
-	array[index] = data
-	return array
+	m, _ := container.(map[string]interface{})
+	if m == nil {
+		m = make(map[string]interface{})
+	}
+	m[segment.name] = setSegmentValue(m[segment.name], path[1:], value)
+	return m
 }
 
 // Internal method creates new map[string]interfaces from given field path and value.
-func (doc *Document) newValue(fieldPath string, value interface{}) map[string]interface{} {
-	path := doc.parseFieldPath(fieldPath)
-	tempMap := make(map[string]interface{})
-	for i := len(path) - 1; i >= 0; i-- {
-		if len(tempMap) == 0 {
-			tempMap[path[i]] = value
-		} else {
-			tempMap = map[string]interface{}{path[i]: tempMap}
-		}
+func (doc *Document) newValue(path []FieldSegment, value interface{}) map[string]interface{} {
+	tempMap := map[string]interface{}{path[len(path)-1].name: value}
+	for i := len(path) - 2; i >= 0; i-- {
+		tempMap = map[string]interface{}{path[i].name: tempMap}
 	}
 	return tempMap
 }
-
-// Internal method parses string field path to well formatted sequence
-func (doc *Document) parseFieldPath(fieldPath string) []string {
-	i := 0
-	result := re.FindAllStringSubmatch(fieldPath, -1)
-	path := make([]string, len(result))
-	for _, m := range result {
-		if m[1] != "" || m[2] != "" {
-			path[i] = fmt.Sprintf(m[1] + m[2])
-			i++
-		}
-	}
-	return path
-}
@@ -1,8 +1,11 @@
 package private_maprdb_go_client
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -161,3 +164,256 @@ func TestSetIdBinary(t *testing.T) {
 		}
 	}
 }
+
+func TestDocument_OjaiTypes(t *testing.T) {
+	price, err := MakeDecimalFromString("1234567890123456789.01")
+	assert.Nil(t, err)
+	doc, err := MakeDocument(
+		SetInt8("byte", -8),
+		SetInt16("short", 1600),
+		SetInt32("int", -320000),
+		SetInt64("long", 1<<40),
+		SetFloat32("float", 1.5),
+		SetFloat64("double", 3),
+		SetDecimal("price", price),
+		SetInterval("ttl", MakeOIntervalFromDuration(36*time.Hour)),
+	)
+	assert.Nil(t, err)
+	ser, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"byte": {"$numberByte": -8},
+		"short": {"$numberShort": 1600},
+		"int": {"$numberInt": -320000},
+		"long": {"$numberLong": 1099511627776},
+		"float": {"$numberFloat": 1.5},
+		"double": 3.0,
+		"price": {"$decimal": "1234567890123456789.01"},
+		"ttl": {"$interval": 129600000}
+	}`, string(ser))
+	assert.Contains(t, string(ser), `"double":3.0`)
+
+	parsed, err := MakeDocumentFromJson(string(ser))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"byte":   int8(-8),
+		"short":  int16(1600),
+		"int":    int32(-320000),
+		"long":   1 << 40,
+		"float":  float32(1.5),
+		"double": float64(3),
+		"price":  price,
+		"ttl":    MakeOIntervalFromMillis(129600000),
+	}, parsed.AsMap())
+	int8Value, err := parsed.GetInt8("byte")
+	assert.Nil(t, err)
+	assert.Equal(t, int8(-8), int8Value)
+	int16Value, err := parsed.GetInt16("short")
+	assert.Nil(t, err)
+	assert.Equal(t, int16(1600), int16Value)
+	intValue, err := parsed.GetInt("int")
+	assert.Nil(t, err)
+	assert.Equal(t, -320000, intValue)
+	int64Value, err := parsed.GetInt64("long")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1<<40), int64Value)
+	_, err = parsed.GetInt16("int")
+	assert.EqualError(t, err, "value -320000 of field int overflows int16")
+	floatValue, err := parsed.GetFloat64("float")
+	assert.Nil(t, err)
+	assert.Equal(t, 1.5, floatValue)
+	decimal, err := parsed.GetDecimal("price")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, price.Cmp(decimal))
+	interval, err := parsed.GetInterval("ttl")
+	assert.Nil(t, err)
+	assert.Equal(t, 36*time.Hour, interval.Duration())
+	assert.Equal(t, 1, interval.Days())
+
+	for _, invalid := range []string{
+		`{"a": {"$numberByte": 128}}`,
+		`{"a": {"$numberInt": 1.5}}`,
+		`{"a": {"$decimal": "1.2.3"}}`,
+		`{"a": {"$interval": "1d"}}`,
+	} {
+		_, err = MakeDocumentFromJson(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		value    string
+		want     string
+		unscaled int64
+		scale    int32
+	}{
+		{"123.45", "123.45", 12345, 2},
+		{"-0.001", "-0.001", -1, 3},
+		{"1.2345e2", "123.45", 12345, 2},
+		{"12E+3", "12000", 12, -3},
+		{"+7", "7", 7, 0},
+		{"0.10", "0.10", 10, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			decimal, err := MakeDecimalFromString(tt.value)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, decimal.String())
+			assert.Equal(t, tt.unscaled, decimal.Unscaled().Int64())
+			assert.Equal(t, tt.scale, decimal.Scale())
+		})
+	}
+	for _, invalid := range []string{"", ".", "1e", "1.2.3", "abc", "1e99999999999"} {
+		_, err := MakeDecimalFromString(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+	tenth, err := MakeDecimalFromFloat64(0.1)
+	assert.Nil(t, err)
+	assert.Equal(t, "0.1", tenth.String())
+	assert.Equal(t, 0, tenth.Cmp(MakeDecimal(big.NewInt(100), 3)))
+	assert.Equal(t, 0.1, tenth.Float64())
+	assert.Equal(t, -1, CompareValues(tenth, 0.2))
+	assert.Equal(t, 1, CompareValues(MakeDecimalFromInt64(2), 1))
+	assert.Equal(t, "decimal", ojaiTypeName(tenth))
+}
+
+func TestDocument_LargeNumbers(t *testing.T) {
+	doc, err := MakeDocumentFromJson(`{
+		"counter": {"$numberLong": 9007199254740993},
+		"quoted": {"$numberLong": "-9223372036854775808"},
+		"plain": 9007199254740993,
+		"unsigned": 18446744073709551615,
+		"double": 1.5,
+		"list": [1, null, {"n": 2e3}]
+	}`)
+	assert.Nil(t, err)
+	counter, err := doc.GetInt64("counter")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9007199254740993), counter)
+	quoted, err := doc.GetInt64("quoted")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-9223372036854775808), quoted)
+	plain, err := doc.GetInt("plain")
+	assert.Nil(t, err)
+	assert.Equal(t, 9007199254740993, plain)
+	assert.Equal(t, uint64(18446744073709551615), doc.AsMap()["unsigned"])
+	_, err = doc.GetInt64("unsigned")
+	assert.EqualError(t, err, "value 18446744073709551615 of field unsigned overflows int64")
+	_, err = doc.GetInt("unsigned")
+	assert.NotNil(t, err)
+	assert.Equal(t, 1.5, doc.AsMap()["double"])
+	for field, want := range map[string]float64{"double": 1.5, "plain": 9007199254740992, "unsigned": 18446744073709551615} {
+		value, err := doc.GetFloat64(field)
+		assert.Nil(t, err)
+		assert.Equal(t, want, value, field)
+	}
+	price, err := MakeDocumentFromJson(`{"price": 10, "discount": {"$numberInt": 2}, "name": "ten"}`)
+	assert.Nil(t, err)
+	for field, want := range map[string]float64{"price": 10, "discount": 2, "name": 0} {
+		value, err := price.GetFloat64(field)
+		assert.Nil(t, err)
+		assert.Equal(t, want, value, field)
+	}
+	assert.Equal(t, []interface{}{int64(1), nil, map[string]interface{}{"n": float64(2000)}}, doc.AsMap()["list"])
+
+	ser, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	parsed, err := MakeDocumentFromJson(string(ser))
+	assert.Nil(t, err)
+	counter, err = parsed.GetInt64("plain")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9007199254740993), counter)
+
+	for _, invalid := range []string{
+		`{"a": {"$numberLong": 1.5}}`,
+		`{"a": {"$numberLong": 9223372036854775808}}`,
+		`{"a": {"$numberFloat": 1e300}}`,
+		`{"a": 1e400}`,
+	} {
+		_, err = MakeDocumentFromJson(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestDocument_CanonicalJson(t *testing.T) {
+	parsed, err := MakeDocumentFromJson(`{"name": "Bob", "_id": "b", "address": {"zip": 10001, "city": "NY"},` +
+		` "tags": [{"y": 1.5, "x": "a"}, null], "quote\"d\\": "v"}`)
+	assert.Nil(t, err)
+	ser, err := json.Marshal(parsed)
+	assert.Nil(t, err)
+	want := `{"name":"Bob","_id":"b","address":{"zip":{"$numberLong":10001},"city":"NY"},` +
+		`"tags":[{"y":1.5,"x":"a"},null],"quote\"d\\":"v"}`
+	assert.Equal(t, want, string(ser))
+	for i := 0; i < 10; i++ {
+		again, err := json.Marshal(parsed)
+		assert.Nil(t, err)
+		assert.Equal(t, ser, again)
+	}
+
+	parsed.SetString("address.street", "Main")
+	parsed.Delete("name")
+	parsed.SetString("name", "Alice")
+	assert.Equal(t, `{"_id":"b","address":{"zip":10001,"city":"NY","street":"Main"},`+
+		`"tags":[{"y":1.5,"x":"a"},null],"quote\"d\\":"v","name":"Alice"}`,
+		parsed.AsJsonString())
+
+	built, err := MakeDocument(SetIdString("id"), SetInt("b", 2), SetString("a.y", "y"), SetString("a.x", "x"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"_id":"id","b":2,"a":{"y":"y","x":"x"}}`, built.AsJsonString())
+	assert.Equal(t, "{\n\t\"_id\": \"id\",\n\t\"b\": 2,\n\t\"a\": {\n\t\t\"y\": \"y\",\n\t\t\"x\": \"x\"\n\t}\n}",
+		built.AsJsonString(JsonIndent("", "\t")))
+
+	unordered := MakeDocumentFromMap(map[string]interface{}{"c": 1, "a": map[string]interface{}{"z": true, "b": nil}})
+	assert.Equal(t, `{"a":{"b":null,"z":true},"c":1}`, unordered.AsJsonString())
+	unordered.SetString("b", "new")
+	assert.Equal(t, `{"a":{"b":null,"z":true},"c":1,"b":"new"}`, unordered.AsJsonString())
+}
+
+func TestDocument_FieldPaths(t *testing.T) {
+	doc, err := MakeDocument(
+		SetString("first-name", "Bob"),
+		SetInt("$price", 10),
+		SetString("with space.город", "NY"),
+		SetString("`a.b`.c", "dotted"),
+		SetSlice("tags", []interface{}{"x", map[string]interface{}{"k": "v"}}),
+		SetString("tags[1].k", "w"),
+		SetString("tags[3]", "z"),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"first-name": "Bob",
+		"$price":     10,
+		"with space": map[string]interface{}{"город": "NY"},
+		"a.b":        map[string]interface{}{"c": "dotted"},
+		"tags":       []interface{}{"x", map[string]interface{}{"k": "w"}, nil, "z"},
+	}, doc.AsMap())
+	name, err := doc.GetString("first-name")
+	assert.Nil(t, err)
+	assert.Equal(t, "Bob", name)
+	dotted, err := doc.GetString("`a.b`.c")
+	assert.Nil(t, err)
+	assert.Equal(t, "dotted", dotted)
+	element, err := doc.GetString("tags[1].k")
+	assert.Nil(t, err)
+	assert.Equal(t, "w", element)
+	assert.False(t, doc.IsPathExists("tags[7]"))
+
+	doc.Delete("tags[0]").Delete("with space.город")
+	assert.Equal(t, []interface{}{map[string]interface{}{"k": "w"}, nil, "z"}, doc.AsMap()["tags"])
+	assert.Equal(t, map[string]interface{}{}, doc.AsMap()["with space"])
+
+	_, err = MakeDocument(SetString("a..b", "x"))
+	assert.EqualError(t, err, `invalid field path "a..b": empty field name at position 2`)
+	_, err = MakeDocument(SetString("tags[]", "x"))
+	assert.EqualError(t, err, "value can't be set to field path tags[] with [] index")
+	_, err = doc.GetString("a[")
+	assert.EqualError(t, err, `invalid field path "a[": unclosed index at position 1`)
+	assert.Equal(t, "Bob", doc.SetString("a..b", "x").AsMap()["first-name"])
+	assert.EqualError(t, doc.Err(), `invalid field path "a..b": empty field name at position 2`)
+	doc.SetString("b[", "y")
+	assert.EqualError(t, doc.Err(), `invalid field path "a..b": empty field name at position 2`)
+	_, err = json.Marshal(doc)
+	assert.Error(t, err)
+	assert.Nil(t, doc.Clean().Err())
+}
@@ -0,0 +1,264 @@
+package private_maprdb_go_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+)
+
+// JsonOption functional option of Document JSON encoding
+type JsonOption func(options *jsonOptions)
+
+type jsonOptions struct {
+	prefix string
+	indent string
+}
+
+// JsonIndent option writes every field and array element on a new line which begins with prefix
+// followed by one or more copies of indent according to the nesting
+func JsonIndent(prefix, indent string) JsonOption {
+	return func(options *jsonOptions) {
+		options.prefix = prefix
+		options.indent = indent
+	}
+}
+
+// fieldOrder keeps the order in which fields of a map were set or parsed.
+// Nested maps are described by the fields of the node, elements of arrays are stored by their index.
+type fieldOrder struct {
+	keys   []string
+	fields map[string]*fieldOrder
+}
+
+// field returns order of the nested value or nil if it's unknown
+func (order *fieldOrder) field(key string) *fieldOrder {
+	if order == nil {
+		return nil
+	}
+	return order.fields[key]
+}
+
+// add appends the key if it's unknown and returns order of its value
+func (order *fieldOrder) add(key string, isMapKey bool) *fieldOrder {
+	if order.fields == nil {
+		order.fields = make(map[string]*fieldOrder)
+	}
+	child, ok := order.fields[key]
+	if !ok && isMapKey {
+		order.keys = append(order.keys, key)
+	}
+	if child == nil {
+		child = &fieldOrder{}
+		order.fields[key] = child
+	}
+	return child
+}
+
+// record adds the field path to the order before its value is set into the content.
+// Fields of the content which aren't in the order yet are added first sorted by name,
+// so the new field follows all of them.
+func (order *fieldOrder) record(content interface{}, path []FieldSegment) {
+	for _, segment := range path {
+		if segment.isIndex {
+			content = getSegmentValue(content, []FieldSegment{segment})
+			order = order.add(strconv.Itoa(segment.index), false)
+			continue
+		}
+		if m, ok := content.(map[string]interface{}); ok {
+			for _, existing := range order.sortKeys(m) {
+				order.add(existing, true)
+			}
+		}
+		content = getSegmentValue(content, []FieldSegment{segment})
+		order = order.add(segment.name, true)
+	}
+}
+
+// remove deletes the last field of the path from the order, orders of the following elements of array are shifted
+func (order *fieldOrder) remove(path []FieldSegment) {
+	for _, segment := range path[:len(path)-1] {
+		if segment.isIndex {
+			order = order.field(strconv.Itoa(segment.index))
+		} else {
+			order = order.field(segment.name)
+		}
+	}
+	if order == nil {
+		return
+	}
+	last := path[len(path)-1]
+	if !last.isIndex {
+		delete(order.fields, last.name)
+		for position, existing := range order.keys {
+			if existing == last.name {
+				order.keys = append(order.keys[:position:position], order.keys[position+1:]...)
+				break
+			}
+		}
+		return
+	}
+	shifted := make(map[string]*fieldOrder, len(order.fields))
+	for key, child := range order.fields {
+		if index, _ := strconv.Atoi(key); index < last.index {
+			shifted[key] = child
+		} else if index > last.index {
+			shifted[strconv.Itoa(index-1)] = child
+		}
+	}
+	order.fields = shifted
+}
+
+// sortKeys returns keys of the map in the recorded order followed by the unknown keys sorted by name
+func (order *fieldOrder) sortKeys(content map[string]interface{}) []string {
+	keys := make([]string, 0, len(content))
+	known := make(map[string]bool, len(content))
+	if order != nil {
+		for _, key := range order.keys {
+			if _, ok := content[key]; ok && !known[key] {
+				keys = append(keys, key)
+				known[key] = true
+			}
+		}
+	}
+	unknown := len(keys)
+	for key := range content {
+		if !known[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys[unknown:])
+	return keys
+}
+
+// encodeJson writes the value as JSON with fields of maps in the given order
+func encodeJson(value interface{}, order *fieldOrder, options []JsonOption) ([]byte, error) {
+	var config jsonOptions
+	for _, option := range options {
+		option(&config)
+	}
+	buffer := &bytes.Buffer{}
+	if err := encodeOrdered(buffer, value, order); err != nil {
+		return nil, err
+	}
+	if len(config.prefix) == 0 && len(config.indent) == 0 {
+		return buffer.Bytes(), nil
+	}
+	indented := &bytes.Buffer{}
+	if err := json.Indent(indented, buffer.Bytes(), config.prefix, config.indent); err != nil {
+		return nil, err
+	}
+	return indented.Bytes(), nil
+}
+
+func encodeOrdered(buffer *bytes.Buffer, value interface{}, order *fieldOrder) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if v == nil {
+			buffer.WriteString("null")
+			return nil
+		}
+		buffer.WriteByte('{')
+		for index, key := range order.sortKeys(v) {
+			if index > 0 {
+				buffer.WriteByte(',')
+			}
+			// Keys are written by encoding/json, so quotes, backslashes and control characters are escaped
+			ser, err := json.Marshal(key)
+			if err != nil {
+				return err
+			}
+			buffer.Write(ser)
+			buffer.WriteByte(':')
+			if err = encodeOrdered(buffer, v[key], order.field(key)); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+	case []interface{}:
+		if v == nil {
+			buffer.WriteString("null")
+			return nil
+		}
+		buffer.WriteByte('[')
+		for index, element := range v {
+			if index > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := encodeOrdered(buffer, element, order.field(strconv.Itoa(index))); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+	default:
+		ser, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		buffer.Write(ser)
+	}
+	return nil
+}
+
+// decodeOrdered reads the next JSON value and the order of fields of its maps.
+// Order isn't returned for maps with OJAI keys, since they are converted into values of OJAI types.
+func decodeOrdered(decoder *json.Decoder) (interface{}, *fieldOrder, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		content := make(map[string]interface{})
+		order := &fieldOrder{}
+		isOjaiValue := false
+		for decoder.More() {
+			token, err = decoder.Token()
+			if err != nil {
+				return nil, nil, err
+			}
+			key, ok := token.(string)
+			if !ok {
+				return nil, nil, errors.New("invalid JSON object key")
+			}
+			if _, ok = ojaiKeys[key]; ok {
+				isOjaiValue = true
+			}
+			value, valueOrder, err := decodeOrdered(decoder)
+			if err != nil {
+				return nil, nil, err
+			}
+			content[key] = value
+			order.add(key, true)
+			order.fields[key] = valueOrder
+		}
+		if _, err = decoder.Token(); err != nil {
+			return nil, nil, err
+		}
+		if isOjaiValue {
+			return content, nil, nil
+		}
+		return content, order, nil
+	case json.Delim('['):
+		content := make([]interface{}, 0)
+		order := &fieldOrder{}
+		for decoder.More() {
+			value, valueOrder, err := decodeOrdered(decoder)
+			if err != nil {
+				return nil, nil, err
+			}
+			if valueOrder != nil {
+				order.add(strconv.Itoa(len(content)), false)
+				order.fields[strconv.Itoa(len(content))] = valueOrder
+			}
+			content = append(content, value)
+		}
+		if _, err = decoder.Token(); err != nil {
+			return nil, nil, err
+		}
+		return content, order, nil
+	default:
+		return token, nil, nil
+	}
+}
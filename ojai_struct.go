@@ -0,0 +1,451 @@
+package private_maprdb_go_client
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Name of the struct tag which maps struct fields to Document fields
+const ojaiTagName = "ojai"
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	durationType   = reflect.TypeOf(time.Duration(0))
+	byteSliceType  = reflect.TypeOf([]byte(nil))
+	documentType   = reflect.TypeOf(Document{})
+	oDateType      = reflect.TypeOf(ODate{})
+	oTimeType      = reflect.TypeOf(OTime{})
+	oTimestampType = reflect.TypeOf(OTimestamp{})
+	oIntervalType  = reflect.TypeOf(OInterval{})
+	decimalType    = reflect.TypeOf(Decimal{})
+)
+
+// structField describes struct field which is mapped to the Document field
+type structField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// MakeDocumentFromStruct function creates and returns new Document from given struct or pointer to struct.
+// Fields are mapped with `ojai:"field,omitempty"` tags, fields without tag use Go field name
+// and fields with "-" tag are skipped. The field tagged as `ojai:"_id"` becomes the Document id.
+// time.Time is stored as OTimestamp, []byte as binary value, time.Duration as OInterval and
+// integers keep their size, e.g. int32 is stored as OJAI int.
+func MakeDocumentFromStruct(value interface{}) (*Document, error) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, fmt.Errorf("can't make document from nil %T", value)
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("can't make document from %T, struct is required", value)
+	}
+	documentMap, err := encodeStruct(v)
+	if err != nil {
+		return nil, err
+	}
+	return MakeDocumentFromMap(documentMap), nil
+}
+
+// Decode method stores content of the Document into the struct, map or other value pointed by given pointer.
+// Struct fields are mapped with `ojai:"field,omitempty"` tags like in MakeDocumentFromStruct,
+// Document fields without corresponding struct field are ignored.
+func (doc *Document) Decode(out interface{}) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("can't decode document into %T, non-nil pointer is required", out)
+	}
+	return decodeValue(doc.documentMap, v.Elem(), "")
+}
+
+// structFields returns Document fields of the struct type, fields of embedded structs are promoted
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get(ojaiTagName)
+		if tag == "-" {
+			continue
+		}
+		name, options := tag, ""
+		if comma := strings.Index(tag, ","); comma >= 0 {
+			name, options = tag[:comma], tag[comma+1:]
+		}
+		if field.Anonymous && len(name) == 0 {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for _, promoted := range structFields(embedded) {
+					promoted.index = append([]int{i}, promoted.index...)
+					fields = append(fields, promoted)
+				}
+				continue
+			}
+		}
+		if len(field.PkgPath) != 0 {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+		fields = append(fields, structField{
+			name:      name,
+			index:     []int{i},
+			omitEmpty: options == "omitempty",
+		})
+	}
+	return fields
+}
+
+// fieldByIndex returns struct field by index, ok is false if embedded pointer on the way is nil
+func fieldByIndex(v reflect.Value, index []int, allocate bool) (reflect.Value, bool) {
+	for i, position := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !allocate {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(position)
+	}
+	return v, true
+}
+
+func encodeStruct(v reflect.Value) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	for _, field := range structFields(v.Type()) {
+		fieldValue, ok := fieldByIndex(v, field.index, false)
+		if !ok || (field.omitEmpty && isEmptyValue(fieldValue)) {
+			continue
+		}
+		encoded, err := encodeValue(fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("field %v: %v", field.name, err)
+		}
+		result[field.name] = encoded
+	}
+	return result, nil
+}
+
+// encodeValue converts Go value into the value which is stored in the Document
+func encodeValue(v reflect.Value) (interface{}, error) {
+	switch v.Type() {
+	case timeType:
+		return MakeOTimestampFromDate(v.Interface().(time.Time)), nil
+	case byteSliceType:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return append([]byte(nil), v.Bytes()...), nil
+	case durationType:
+		return MakeOIntervalFromDuration(time.Duration(v.Int())), nil
+	case oDateType, oTimeType, oTimestampType, oIntervalType, decimalType:
+		pointer := reflect.New(v.Type())
+		pointer.Elem().Set(v)
+		return pointer.Interface(), nil
+	case documentType:
+		doc := v.Interface().(Document)
+		return copyMap(doc.documentMap), nil
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeValue(v.Elem())
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		switch v.Type().Elem() {
+		case oDateType, oTimeType, oTimestampType, oIntervalType, decimalType:
+			return v.Interface(), nil
+		}
+		return encodeValue(v.Elem())
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int:
+		return int(v.Int()), nil
+	case reflect.Int8:
+		return int8(v.Int()), nil
+	case reflect.Int16:
+		return int16(v.Int()), nil
+	case reflect.Int32:
+		return int32(v.Int()), nil
+	case reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > math.MaxInt64 {
+			return nil, fmt.Errorf("value %v overflows int", v.Uint())
+		}
+		return int(v.Uint()), nil
+	case reflect.Float32:
+		return float32(v.Float()), nil
+	case reflect.Float64:
+		return v.Float(), nil
+	case reflect.Struct:
+		return encodeStruct(v)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil, nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(bytes), v)
+			return bytes, nil
+		}
+		result := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			element, err := encodeValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			result[i] = element
+		}
+		return result, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type %v", v.Type().Key())
+		}
+		result := make(map[string]interface{}, v.Len())
+		iterator := v.MapRange()
+		for iterator.Next() {
+			element, err := encodeValue(iterator.Value())
+			if err != nil {
+				return nil, err
+			}
+			result[iterator.Key().String()] = element
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unsupported value type %v", v.Type())
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).IsZero()
+		}
+	}
+	return false
+}
+
+// decodeValue stores Document value into Go value, path is used in error messages
+func decodeValue(value interface{}, out reflect.Value, path string) error {
+	if value == nil {
+		out.Set(reflect.Zero(out.Type()))
+		return nil
+	}
+	if out.Kind() == reflect.Interface && out.NumMethod() == 0 {
+		out.Set(reflect.ValueOf(value))
+		return nil
+	}
+	switch out.Type() {
+	case timeType:
+		switch v := value.(type) {
+		case *OTimestamp:
+			out.Set(reflect.ValueOf(v.DateTime()))
+			return nil
+		case *ODate:
+			out.Set(reflect.ValueOf(v.GetDate()))
+			return nil
+		}
+		return decodeError(value, out, path)
+	case durationType:
+		if v, ok := value.(*OInterval); ok {
+			out.SetInt(int64(v.Duration()))
+			return nil
+		}
+		return decodeError(value, out, path)
+	case oDateType, oTimeType, oTimestampType, oIntervalType, decimalType:
+		v := reflect.ValueOf(value)
+		if v.Kind() == reflect.Ptr && v.Type().Elem() == out.Type() {
+			out.Set(v.Elem())
+			return nil
+		}
+		return decodeError(value, out, path)
+	case byteSliceType:
+		switch v := value.(type) {
+		case []byte:
+			out.SetBytes(append([]byte(nil), v...))
+			return nil
+		case string:
+			out.SetBytes([]byte(v))
+			return nil
+		}
+		return decodeError(value, out, path)
+	}
+	switch out.Kind() {
+	case reflect.Ptr:
+		if reflect.TypeOf(value) == out.Type() {
+			out.Set(reflect.ValueOf(value))
+			return nil
+		}
+		if out.IsNil() {
+			out.Set(reflect.New(out.Type().Elem()))
+		}
+		return decodeValue(value, out.Elem(), path)
+	case reflect.Bool:
+		if v, ok := value.(bool); ok {
+			out.SetBool(v)
+			return nil
+		}
+	case reflect.String:
+		if v, ok := value.(string); ok {
+			out.SetString(v)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, ok := toNumber(value); ok {
+			i := n.i
+			if !n.isInt {
+				if n.f != math.Trunc(n.f) || n.f < math.MinInt64 || n.f >= math.MaxInt64 {
+					return decodeError(value, out, path)
+				}
+				i = int64(n.f)
+			}
+			if out.OverflowInt(i) {
+				return fmt.Errorf("value %v of field %v overflows %v", value, path, out.Type())
+			}
+			out.SetInt(i)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v, ok := value.(uint64); ok {
+			if out.OverflowUint(v) {
+				return fmt.Errorf("value %v of field %v overflows %v", value, path, out.Type())
+			}
+			out.SetUint(v)
+			return nil
+		}
+		if n, ok := toNumber(value); ok {
+			i := n.i
+			if !n.isInt {
+				if n.f != math.Trunc(n.f) || n.f < 0 || n.f >= math.MaxInt64 {
+					return decodeError(value, out, path)
+				}
+				i = int64(n.f)
+			}
+			if i < 0 || out.OverflowUint(uint64(i)) {
+				return fmt.Errorf("value %v of field %v overflows %v", value, path, out.Type())
+			}
+			out.SetUint(uint64(i))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if n, ok := toNumber(value); ok {
+			if n.isInt {
+				out.SetFloat(float64(n.i))
+			} else {
+				out.SetFloat(n.f)
+			}
+			return nil
+		}
+	case reflect.Slice:
+		if list, ok := value.([]interface{}); ok {
+			slice := reflect.MakeSlice(out.Type(), len(list), len(list))
+			for i, element := range list {
+				err := decodeValue(element, slice.Index(i), fmt.Sprintf("%v[%v]", path, i))
+				if err != nil {
+					return err
+				}
+			}
+			out.Set(slice)
+			return nil
+		}
+	case reflect.Array:
+		if list, ok := value.([]interface{}); ok {
+			if len(list) > out.Len() {
+				return fmt.Errorf("array of field %v has %v elements, more than %v", path, len(list), out.Type())
+			}
+			out.Set(reflect.Zero(out.Type()))
+			for i, element := range list {
+				err := decodeValue(element, out.Index(i), fmt.Sprintf("%v[%v]", path, i))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case reflect.Map:
+		if m, ok := value.(map[string]interface{}); ok {
+			if out.Type().Key().Kind() != reflect.String {
+				return fmt.Errorf("unsupported map key type %v of field %v", out.Type().Key(), path)
+			}
+			result := reflect.MakeMapWithSize(out.Type(), len(m))
+			for key, element := range m {
+				elementValue := reflect.New(out.Type().Elem()).Elem()
+				err := decodeValue(element, elementValue, joinFieldPath(path, key))
+				if err != nil {
+					return err
+				}
+				result.SetMapIndex(reflect.ValueOf(key).Convert(out.Type().Key()), elementValue)
+			}
+			out.Set(result)
+			return nil
+		}
+	case reflect.Struct:
+		if m, ok := value.(map[string]interface{}); ok {
+			if out.Type() == documentType {
+				out.Set(reflect.ValueOf(*MakeDocumentFromMap(copyMap(m))))
+				return nil
+			}
+			for _, field := range structFields(out.Type()) {
+				element, ok := m[field.name]
+				if !ok {
+					continue
+				}
+				fieldValue, _ := fieldByIndex(out, field.index, true)
+				err := decodeValue(element, fieldValue, joinFieldPath(path, field.name))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	return decodeError(value, out, path)
+}
+
+func joinFieldPath(path, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+	return path + "." + name
+}
+
+func decodeError(value interface{}, out reflect.Value, path string) error {
+	if len(path) == 0 {
+		return fmt.Errorf("can't decode %T into %v", value, out.Type())
+	}
+	return fmt.Errorf("can't decode %T into %v field %v", value, out.Type(), path)
+}
@@ -0,0 +1,124 @@
+package private_maprdb_go_client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type structAddress struct {
+	City string `ojai:"city"`
+	Zip  string `ojai:"zip,omitempty"`
+}
+
+type structAudit struct {
+	Created time.Time `ojai:"created"`
+}
+
+type structUser struct {
+	structAudit
+	Id       string         `ojai:"_id"`
+	Name     string         `ojai:"name"`
+	Age      int16          `ojai:"age,omitempty"`
+	Score    float32        `ojai:"score"`
+	Active   bool           `ojai:"active"`
+	Avatar   []byte         `ojai:"avatar,omitempty"`
+	Address  *structAddress `ojai:"address,omitempty"`
+	Tags     []string       `ojai:"tags"`
+	Labels   map[string]int `ojai:"labels,omitempty"`
+	Birthday *ODate         `ojai:"birthday,omitempty"`
+	Extra    interface{}    `ojai:"extra,omitempty"`
+	Secret   string         `ojai:"-"`
+	Untagged string
+	internal string
+}
+
+func TestMakeDocumentFromStruct(t *testing.T) {
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	user := structUser{
+		structAudit: structAudit{Created: created},
+		Id:          "user1",
+		Name:        "John",
+		Score:       4.5,
+		Avatar:      []byte{1, 2},
+		Address:     &structAddress{City: "Boston"},
+		Tags:        []string{"a", "b"},
+		Secret:      "password",
+		Untagged:    "value",
+		internal:    "internal",
+	}
+	doc, err := MakeDocumentFromStruct(&user)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"_id":      "user1",
+		"created":  MakeOTimestampFromDate(created),
+		"name":     "John",
+		"score":    float32(4.5),
+		"active":   false,
+		"avatar":   []byte{1, 2},
+		"address":  map[string]interface{}{"city": "Boston"},
+		"tags":     []interface{}{"a", "b"},
+		"Untagged": "value",
+	}, doc.AsMap())
+
+	_, err = MakeDocumentFromStruct(map[string]interface{}{"_id": "id"})
+	assert.NotNil(t, err)
+	_, err = MakeDocumentFromStruct((*structUser)(nil))
+	assert.NotNil(t, err)
+	_, err = MakeDocumentFromStruct(struct{ Channel chan int }{make(chan int)})
+	assert.NotNil(t, err)
+
+	zero, err := MakeDocumentFromStruct(struct{ Price Decimal }{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Price":"0"}`, zero.AsJsonString())
+	price, err := zero.GetDecimal("Price")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), price.Unscaled().Int64())
+	assert.Equal(t, 0.0, price.Float64())
+}
+
+func TestDocument_Decode(t *testing.T) {
+	doc, err := MakeDocumentFromJson(`{
+		"_id": "user1",
+		"created": {"$date": "2020-01-02T03:04:05Z"},
+		"name": "John",
+		"age": {"$numberLong": 35},
+		"score": 4.5,
+		"active": true,
+		"avatar": {"$binary": "AQI="},
+		"address": {"city": "Boston", "zip": "02101"},
+		"tags": ["a", "b"],
+		"labels": {"x": 1},
+		"birthday": {"$dateDay": "1985-04-12"},
+		"extra": [1, "two"],
+		"unknown": "ignored"
+	}`)
+	assert.Nil(t, err)
+	var user structUser
+	assert.Nil(t, doc.Decode(&user))
+	assert.Equal(t, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), user.Created)
+	assert.Equal(t, "user1", user.Id)
+	assert.Equal(t, "John", user.Name)
+	assert.Equal(t, int16(35), user.Age)
+	assert.Equal(t, float32(4.5), user.Score)
+	assert.True(t, user.Active)
+	assert.Equal(t, []byte{1, 2}, user.Avatar)
+	assert.Equal(t, &structAddress{City: "Boston", Zip: "02101"}, user.Address)
+	assert.Equal(t, []string{"a", "b"}, user.Tags)
+	assert.Equal(t, map[string]int{"x": 1}, user.Labels)
+	assert.Equal(t, 1985, user.Birthday.GetYear())
+	assert.Equal(t, []interface{}{int64(1), "two"}, user.Extra)
+
+	var m map[string]interface{}
+	assert.Nil(t, doc.Decode(&m))
+	assert.Equal(t, "John", m["name"])
+
+	assert.NotNil(t, doc.Decode(user))
+	overflow := MakeDocumentFromMap(map[string]interface{}{"age": 100000})
+	assert.NotNil(t, overflow.Decode(&user))
+	fraction := MakeDocumentFromMap(map[string]interface{}{"age": 1.5})
+	assert.NotNil(t, fraction.Decode(&user))
+	wrongType := MakeDocumentFromMap(map[string]interface{}{"tags": "a"})
+	assert.NotNil(t, wrongType.Decode(&user))
+}
@@ -0,0 +1,306 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// PageCursor opaque position after the last document of a page, it can be passed to clients
+// of HTTP APIs and given back to DocumentStore.Paginate to continue iteration from the next page
+type PageCursor string
+
+// Paginator iterates over the query result page by page using keyset pagination.
+// Every page is requested with a condition on the order fields and _id of the last document of
+// the previous page instead of offset, so the pages don't shift when documents are inserted or
+// deleted concurrently and deep pages don't rescan the skipped documents.
+// Every document of the result must contain all order fields with non-null values, since the cursor
+// can't be placed after a document without them, Next fails with error on such document.
+type Paginator struct {
+	documentStore *DocumentStore
+	content       map[string]interface{}
+	orderBy       []pageOrderField
+	pageSize      int
+	cursor        PageCursor
+	after         []interface{}
+	page          []*Document
+	hasMore       bool
+	err           error
+}
+
+// pageOrderField field of the query order, _id is always the last one
+type pageOrderField struct {
+	fieldPath string
+	order     Order
+}
+
+// pageCursorJson content of the cursor, order field paths are kept to detect cursors of other queries
+type pageCursorJson struct {
+	OrderBy []string      `json:"orderBy"`
+	Values  []interface{} `json:"values"`
+}
+
+// Paginate method returns Paginator over the result of the query, query may be nil to iterate over all documents.
+// Documents are ordered by the fields of the query order followed by _id, the fields are added to the projection
+// of the query if it has one. Order fields are required in every document of the result, use a condition
+// on their existence if some documents may not have them. Query can't have offset and limit,
+// pageSize limits documents of every page.
+// Iteration starts from the first page if the cursor is empty or from the page after the cursor otherwise.
+func (documentStore *DocumentStore) Paginate(query *Query, pageSize int, cursor PageCursor) (*Paginator, error) {
+	if pageSize <= 0 {
+		return nil, errors.New("page size must be positive")
+	}
+	content := make(map[string]interface{})
+	if query != nil {
+		for key, value := range query.content {
+			content[key] = value
+		}
+	}
+	if _, ok := content[operations[OFFSET]]; ok {
+		return nil, errors.New("paginated query can't have offset")
+	}
+	if _, ok := content[operations[LIMIT]]; ok {
+		return nil, errors.New("paginated query can't have limit, use page size instead")
+	}
+	orderBy, err := parsePageOrder(content[operations[ORDER_BY]])
+	if err != nil {
+		return nil, err
+	}
+	var order []interface{}
+	for _, field := range orderBy {
+		order = append(order, map[string]interface{}{field.fieldPath: orders[field.order]})
+	}
+	content[operations[ORDER_BY]] = order
+	if fieldPaths, ok := content[operations[SELECT]].([]interface{}); ok {
+		fieldPaths = append([]interface{}{}, fieldPaths...)
+		for _, field := range orderBy {
+			if !sliceContains(field.fieldPath, fieldPaths) {
+				fieldPaths = append(fieldPaths, field.fieldPath)
+			}
+		}
+		content[operations[SELECT]] = fieldPaths
+	}
+	paginator := &Paginator{
+		documentStore: documentStore,
+		content:       content,
+		orderBy:       orderBy,
+		pageSize:      pageSize,
+		cursor:        cursor,
+		hasMore:       true,
+	}
+	if len(cursor) != 0 {
+		if paginator.after, err = paginator.decodeCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+	return paginator, nil
+}
+
+// parsePageOrder converts $orderby of the query into the list of order fields ending with _id
+func parsePageOrder(value interface{}) ([]pageOrderField, error) {
+	var orderBy []pageOrderField
+	entries, _ := value.([]interface{})
+	if value != nil && entries == nil {
+		return nil, fmt.Errorf("invalid order of paginated query %v", value)
+	}
+	for _, entry := range entries {
+		switch e := entry.(type) {
+		case string:
+			orderBy = append(orderBy, pageOrderField{fieldPath: e, order: ASC})
+		case map[string]interface{}:
+			if len(e) != 1 {
+				return nil, fmt.Errorf("invalid order of paginated query %v", entry)
+			}
+			for fieldPath, order := range e {
+				field := pageOrderField{fieldPath: fieldPath, order: ASC}
+				if order == orders[DESC] {
+					field.order = DESC
+				}
+				orderBy = append(orderBy, field)
+			}
+		default:
+			return nil, fmt.Errorf("invalid order of paginated query %v", entry)
+		}
+	}
+	for _, field := range orderBy {
+		if field.fieldPath == "_id" {
+			return orderBy, nil
+		}
+	}
+	return append(orderBy, pageOrderField{fieldPath: "_id", order: ASC}), nil
+}
+
+// Next method requests the next page and returns true if it isn't empty, the page is available through Page method.
+// Next returns false when all pages were read or an error occurred, Err must be checked after Next returns false.
+func (paginator *Paginator) Next() bool {
+	return paginator.NextWithContext(nil)
+}
+
+// NextWithContext method requests the next page like Next.
+// User defined context is required for this method.
+func (paginator *Paginator) NextWithContext(ctx context.Context) bool {
+	paginator.page = nil
+	if paginator.err != nil || !paginator.hasMore {
+		return false
+	}
+	page, err := paginator.fetch(ctx)
+	if err == nil {
+		paginator.hasMore = len(page) > paginator.pageSize
+		if paginator.hasMore {
+			page = page[:paginator.pageSize]
+		}
+		if len(page) != 0 {
+			err = paginator.advance(page[len(page)-1])
+		}
+	}
+	if err != nil {
+		paginator.err = err
+		paginator.hasMore = false
+		return false
+	}
+	paginator.page = page
+	return len(page) != 0
+}
+
+// fetch reads one document more than the page size, so the end of the result is known without an extra request
+func (paginator *Paginator) fetch(ctx context.Context) ([]*Document, error) {
+	content := paginator.pageQuery()
+	queryResult, err := paginator.documentStore.find(&content, &FindOptions{ResultAsDocument: true}, ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer queryResult.Close()
+	var page []*Document
+	for queryResult.NextWithContext(ctx) {
+		page = append(page, queryResult.Document())
+	}
+	return page, queryResult.Err()
+}
+
+// pageQuery returns content of the query for the page after the current cursor
+func (paginator *Paginator) pageQuery() map[string]interface{} {
+	content := make(map[string]interface{}, len(paginator.content)+1)
+	for key, value := range paginator.content {
+		content[key] = value
+	}
+	content[operations[LIMIT]] = paginator.pageSize + 1
+	if paginator.after == nil {
+		return content
+	}
+	// Documents after the cursor have greater value of one of the order fields and equal values of the previous ones
+	var alternatives []interface{}
+	for index, field := range paginator.orderBy {
+		var conditions []interface{}
+		for previous := 0; previous < index; previous++ {
+			conditions = append(conditions, map[string]interface{}{
+				comparisonQueryOperations[EQUAL]: map[string]interface{}{
+					paginator.orderBy[previous].fieldPath: paginator.after[previous],
+				},
+			})
+		}
+		comparison := comparisonQueryOperations[GREATER]
+		if field.order == DESC {
+			comparison = comparisonQueryOperations[LESS]
+		}
+		conditions = append(conditions, map[string]interface{}{
+			comparison: map[string]interface{}{field.fieldPath: paginator.after[index]},
+		})
+		alternatives = append(alternatives, logicalCondition(AND, conditions))
+	}
+	keyset := logicalCondition(OR, alternatives)
+	if where, ok := content[operations[WHERE]]; ok {
+		keyset = map[string]interface{}{logicalOperations[AND]: []interface{}{where, keyset}}
+	}
+	content[operations[WHERE]] = keyset
+	return content
+}
+
+// logicalCondition combines conditions with the logical operation, a single condition is returned as is
+func logicalCondition(operation logicalOperation, conditions []interface{}) interface{} {
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return map[string]interface{}{logicalOperations[operation]: conditions}
+}
+
+// advance moves the cursor after the document, values of the order fields are kept in OJAI format
+func (paginator *Paginator) advance(doc *Document) error {
+	values := make([]interface{}, 0, len(paginator.orderBy))
+	fieldPaths := make([]string, 0, len(paginator.orderBy))
+	for _, field := range paginator.orderBy {
+		value, err := doc.get(field.fieldPath)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			return fmt.Errorf("paginated document doesn't contain order field %v", field.fieldPath)
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value, err = throughMap(copyMap(v))
+		case []interface{}:
+			value, err = throughArray(copyArray(v))
+		default:
+			value = ojaiTypeConversion(v)
+		}
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+		fieldPaths = append(fieldPaths, field.fieldPath)
+	}
+	ser, err := json.Marshal(pageCursorJson{OrderBy: fieldPaths, Values: values})
+	if err != nil {
+		return err
+	}
+	paginator.cursor = PageCursor(base64.RawURLEncoding.EncodeToString(ser))
+	paginator.after = values
+	return nil
+}
+
+// decodeCursor returns values of the order fields kept in the cursor
+func (paginator *Paginator) decodeCursor(cursor PageCursor) ([]interface{}, error) {
+	ser, err := base64.RawURLEncoding.DecodeString(string(cursor))
+	if err != nil {
+		return nil, fmt.Errorf("invalid page cursor %v", cursor)
+	}
+	var decoded pageCursorJson
+	decoder := json.NewDecoder(strings.NewReader(string(ser)))
+	// Numbers are kept as they are, so large _id values and longs don't lose precision
+	decoder.UseNumber()
+	if err = decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("invalid page cursor %v", cursor)
+	}
+	fieldPaths := make([]string, 0, len(paginator.orderBy))
+	for _, field := range paginator.orderBy {
+		fieldPaths = append(fieldPaths, field.fieldPath)
+	}
+	if !reflect.DeepEqual(fieldPaths, decoded.OrderBy) || len(decoded.Values) != len(fieldPaths) {
+		return nil, errors.New("page cursor doesn't match order of the query")
+	}
+	return decoded.Values, nil
+}
+
+// Page method returns documents of the page read by the last successful call of Next.
+func (paginator *Paginator) Page() []*Document {
+	return paginator.page
+}
+
+// Cursor method returns cursor after the last read page or the cursor given to Paginate if pages weren't read yet.
+func (paginator *Paginator) Cursor() PageCursor {
+	return paginator.cursor
+}
+
+// HasMore method checks whether there may be documents after the cursor, it's true before the first call of Next.
+func (paginator *Paginator) HasMore() bool {
+	return paginator.hasMore
+}
+
+// Err method returns the error occurred during the iteration or nil.
+func (paginator *Paginator) Err() error {
+	return paginator.err
+}
@@ -0,0 +1,85 @@
+package private_maprdb_go_client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginator_PageQuery(t *testing.T) {
+	query, err := MakeQuery(
+		Select("name"),
+		WhereMap(map[string]interface{}{"$ge": map[string]interface{}{"age": 30}}),
+		OrderBy(DESC, "age"),
+	)
+	assert.Nil(t, err)
+	paginator, err := (&DocumentStore{}).Paginate(query, 2, "")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"$select":  []interface{}{"name", "age", "_id"},
+		"$where":   map[string]interface{}{"$ge": map[string]interface{}{"age": map[string]interface{}{"$numberLong": 30}}},
+		"$orderby": []interface{}{map[string]interface{}{"age": "desc"}, map[string]interface{}{"_id": "asc"}},
+		"$limit":   3,
+	}, paginator.pageQuery())
+	assert.Equal(t, []interface{}{"name"}, query.content["$select"])
+
+	assert.Nil(t, paginator.advance(MakeDocumentFromMap(map[string]interface{}{"_id": "b", "age": 35, "name": "Bob"})))
+	assert.Equal(t, map[string]interface{}{"$and": []interface{}{
+		map[string]interface{}{"$ge": map[string]interface{}{"age": map[string]interface{}{"$numberLong": 30}}},
+		map[string]interface{}{"$or": []interface{}{
+			map[string]interface{}{"$lt": map[string]interface{}{"age": map[string]interface{}{"$numberLong": 35}}},
+			map[string]interface{}{"$and": []interface{}{
+				map[string]interface{}{"$eq": map[string]interface{}{"age": map[string]interface{}{"$numberLong": 35}}},
+				map[string]interface{}{"$gt": map[string]interface{}{"_id": "b"}},
+			}},
+		}},
+	}}, paginator.pageQuery()["$where"])
+
+	resumed, err := (&DocumentStore{}).Paginate(query, 2, paginator.Cursor())
+	assert.Nil(t, err)
+	assert.Equal(t, paginator.Cursor(), resumed.Cursor())
+	ser, err := json.Marshal(resumed.pageQuery()["$where"])
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"$and": [{"$ge": {"age": {"$numberLong": 30}}}, {"$or": [`+
+		`{"$lt": {"age": {"$numberLong": 35}}}, {"$and": [{"$eq": {"age": {"$numberLong": 35}}}, {"$gt": {"_id": "b"}}]}]}]}`,
+		string(ser))
+
+	err = paginator.advance(MakeDocumentFromMap(map[string]interface{}{"_id": "c"}))
+	assert.EqualError(t, err, "paginated document doesn't contain order field age")
+}
+
+func TestPaginator_Invalid(t *testing.T) {
+	byId, err := MakeQuery(OrderBy(ASC, "_id"))
+	assert.Nil(t, err)
+	cursor := PageCursor("eyJvcmRlckJ5IjpbIl9pZCJdLCJ2YWx1ZXMiOlsiYSJdfQ") // {"orderBy":["_id"],"values":["a"]}
+	paginator, err := (&DocumentStore{}).Paginate(byId, 1, cursor)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"$gt": map[string]interface{}{"_id": "a"}}, paginator.pageQuery()["$where"])
+
+	withOffset, err := MakeQuery(Offset(10))
+	assert.Nil(t, err)
+	withLimit, err := MakeQuery(Limit(10))
+	assert.Nil(t, err)
+	byAge, err := MakeQuery(OrderBy(ASC, "age"))
+	assert.Nil(t, err)
+	tests := []struct {
+		name     string
+		query    *Query
+		pageSize int
+		cursor   PageCursor
+		want     string
+	}{
+		{"zero page size", nil, 0, "", "page size must be positive"},
+		{"offset", withOffset, 1, "", "paginated query can't have offset"},
+		{"limit", withLimit, 1, "", "paginated query can't have limit, use page size instead"},
+		{"invalid cursor", nil, 1, "!", "invalid page cursor !"},
+		{"cursor of other order", byAge, 1, cursor, "page cursor doesn't match order of the query"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&DocumentStore{}).Paginate(tt.query, tt.pageSize, tt.cursor)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
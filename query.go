@@ -2,6 +2,7 @@ package private_maprdb_go_client
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 )
 
@@ -35,6 +36,7 @@ const (
 	ORDER_BY
 	OFFSET
 	LIMIT
+	OPTIONS
 )
 
 // String representation of query operations
@@ -44,8 +46,16 @@ var operations = [...]string{
 	"$orderby",
 	"$offset",
 	"$limit",
+	"$options",
 }
 
+// Query options of MapR-DB optimizer which are used as index hints
+const (
+	indexHintOption    = "ojai.mapr.query.hint-using-index"
+	noIndexHintOption  = "ojai.mapr.query.hint-not-using-index"
+	noIndexOptionValue = "NONE"
+)
+
 type QueryOptions func(query *Query) (*Query, error)
 
 // MakeQuery creates empty Query struct
@@ -203,6 +213,68 @@ func (query *Query) CleanLimit() *Query {
 	return query.clean(LIMIT)
 }
 
+// UseIndex sets the index hint of the query, so the optimizer uses one of the named indexes.
+// The hint is ignored by server if the named indexes can't be used for the query.
+func UseIndex(indexNames ...string) QueryOptions {
+	return func(query *Query) (*Query, error) {
+		if len(indexNames) == 0 {
+			return nil, errors.New("index hint must contain at least one index name")
+		}
+		return query.setOption(indexHintOption, indexNames)
+	}
+}
+
+// ForbidIndex sets the index hint of the query, so the optimizer doesn't use the named indexes.
+// If index names are not specified, the query doesn't use any index and scans the table.
+func ForbidIndex(indexNames ...string) QueryOptions {
+	return func(query *Query) (*Query, error) {
+		if len(indexNames) == 0 {
+			return query.setOption(indexHintOption, noIndexOptionValue)
+		}
+		return query.setOption(noIndexHintOption, indexNames)
+	}
+}
+
+// setOption method sets the value of query option, index names can't be both used and forbidden
+func (query *Query) setOption(option string, value interface{}) (*Query, error) {
+	options, _ := query.content[operations[OPTIONS]].(map[string]interface{})
+	if options == nil {
+		options = make(map[string]interface{})
+	}
+	if names, ok := value.([]string); ok {
+		conflicting := indexHintOption
+		if option == indexHintOption {
+			conflicting = noIndexHintOption
+		}
+		for _, name := range names {
+			if len(name) == 0 {
+				return nil, errors.New("index name can't be empty")
+			}
+			if existing, ok := options[conflicting].([]string); ok && containsString(existing, name) {
+				return nil, fmt.Errorf("index %v can't be both used and forbidden", name)
+			}
+		}
+	}
+	options[option] = value
+	query.content[operations[OPTIONS]] = options
+	return query, nil
+}
+
+// containsString checks whether the list contains the value
+func containsString(list []string, value string) bool {
+	for _, element := range list {
+		if element == value {
+			return true
+		}
+	}
+	return false
+}
+
+// CleanOptions removes index hints and other options from query content if they exist
+func (query *Query) CleanOptions() *Query {
+	return query.clean(OPTIONS)
+}
+
 func (query *Query) clean(operator Operation) *Query {
 	if _, ok := query.content[operations[operator]]; ok {
 		delete(query.content, operations[operator])
@@ -2,7 +2,6 @@ package private_maprdb_go_client
 
 import (
 	"errors"
-	"fmt"
 	"gopkg.in/karalabe/cookiejar.v1/collections/deque"
 )
 
@@ -139,11 +138,12 @@ func Or() ConditionOptions {
 // ElementAnd adds the logical operator 'elementAnd' in query condition
 func ElementAnd(fieldPath string) ConditionOptions {
 	return func(condition *Condition) (*Condition, error) {
-		if len(fieldPath) == 0 {
-			return nil, fmt.Errorf("fieldPath can't be empty")
+		path, err := MakeFieldPath(fieldPath)
+		if err != nil {
+			return nil, err
 		}
 		condition.tokens.PushRight(ELEMENT_AND)
-		condition.tokens.PushRight(fieldPath)
+		condition.tokens.PushRight(path.String())
 		return condition, nil
 	}
 }
@@ -160,78 +160,48 @@ func Close() ConditionOptions {
 
 // Adds a condition that tests for existence of the specified.
 func Exists(fieldPath string) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{conditionQueryOperations[EXISTS]: fieldPath})
-		return condition, nil
-	}
+	return existsCondition(conditionQueryOperations[EXISTS], fieldPath)
 }
 
 // Adds a condition that tests for non-existence of the specified fieldPath.
 func NotExists(fieldPath string) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{conditionQueryOperations[NOT_EXISTS]: fieldPath})
-		return condition, nil
-	}
+	return existsCondition(conditionQueryOperations[NOT_EXISTS], fieldPath)
 }
 
 // Adds a condition that tests if the value at the specified
 // fieldPath is equal to at least one of the values in the specified list.
 func In(fieldPath string, valueList []interface{}) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{
-			conditionQueryOperations[IN]: map[string]interface{}{fieldPath: valueList}})
-		return condition, nil
-	}
+	return fieldCondition(conditionQueryOperations[IN], fieldPath, valueList)
 }
 
 // Adds a condition that tests if the value at the specified
 // fieldPath is not equal to any of the values in the
 func NotIn(fieldPath string, valueList []interface{}) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{
-			conditionQueryOperations[NOT_IN]: map[string]interface{}{fieldPath: valueList}})
-		return condition, nil
-	}
+	return fieldCondition(conditionQueryOperations[NOT_IN], fieldPath, valueList)
 }
 
 // Adds a condition that tests if the value at the specified
 // fieldPath is of the specified valueType.
 func TypeOf(fieldPath string, valueType interface{}) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{
-			conditionQueryOperations[TYPE_OF]: map[string]interface{}{fieldPath: valueType}})
-		return condition, nil
-	}
+	return fieldCondition(conditionQueryOperations[TYPE_OF], fieldPath, valueType)
 }
 
 // Adds a condition that tests if the value at the specified
 // fieldPath is not of the specified valueType.
 func NotTypeOf(fieldPath string, valueType interface{}) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{
-			conditionQueryOperations[NOT_TYPE_OF]: map[string]interface{}{fieldPath: valueType}})
-		return condition, nil
-	}
+	return fieldCondition(conditionQueryOperations[NOT_TYPE_OF], fieldPath, valueType)
 }
 
 // Adds a condition that tests if the value at the specified
 // fieldPath is a string and matches the specified regular expression.
 func Matches(fieldPath string, regex interface{}) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{
-			conditionQueryOperations[MATCHES]: map[string]interface{}{fieldPath: regex}})
-		return condition, nil
-	}
+	return fieldCondition(conditionQueryOperations[MATCHES], fieldPath, regex)
 }
 
 // Adds a condition that tests if the value at the specified
 // fieldPath is a string and does not match the specified regular expression.
 func NotMatches(fieldPath string, regex interface{}) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{
-			conditionQueryOperations[NOT_MATCHES]: map[string]interface{}{fieldPath: regex}})
-		return condition, nil
-	}
+	return fieldCondition(conditionQueryOperations[NOT_MATCHES], fieldPath, regex)
 }
 
 // Adds a condition that tests if the value at the specified
@@ -252,22 +222,14 @@ func NotLike(fieldPath string, likeExpr ...interface{}) ConditionOptions {
 // fieldPath equals the specified value. Two values are considered equal if and only if they contain the same
 // key-value pair in the same order.
 func Equals(fieldPath string, value interface{}) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{
-			comparisonQueryOperations[EQUAL]: map[string]interface{}{fieldPath: value}})
-		return condition, nil
-	}
+	return fieldCondition(comparisonQueryOperations[EQUAL], fieldPath, value)
 }
 
 // Adds a condition that tests if the Value at the specified
 // fieldPath does not equal the specified value.
 // Two values are considered equal if and only if they contain the same key-value pair in the same order.
 func NotEquals(fieldPath string, value interface{}) ConditionOptions {
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{
-			comparisonQueryOperations[NOT_EQUAL]: map[string]interface{}{fieldPath: value}})
-		return condition, nil
-	}
+	return fieldCondition(comparisonQueryOperations[NOT_EQUAL], fieldPath, value)
 }
 
 // Adds existing condition into new Query Condition
@@ -293,8 +255,30 @@ func AddConditionMap(conditionToAdd map[string]interface{}) ConditionOptions {
 // Adds a condition that tests if the value at the specified
 // fieldPath satisfies the given Op against the specified value.
 func Is(fieldPath string, op Comparison, value interface{}) ConditionOptions {
+	return fieldCondition(comparisonQueryOperations[op], fieldPath, value)
+}
+
+// fieldCondition returns option which adds {operation: {fieldPath: value}} condition, the field path is validated
+// and written in canonical form
+func fieldCondition(operation string, fieldPath string, value interface{}) ConditionOptions {
+	return func(condition *Condition) (*Condition, error) {
+		path, err := MakeFieldPath(fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		condition.tokens.PushRight(map[string]interface{}{operation: map[string]interface{}{path.String(): value}})
+		return condition, nil
+	}
+}
+
+// existsCondition returns option which adds {operation: fieldPath} condition of existence of the field
+func existsCondition(operation string, fieldPath string) ConditionOptions {
 	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{comparisonQueryOperations[op]: map[string]interface{}{fieldPath: value}})
+		path, err := MakeFieldPath(fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		condition.tokens.PushRight(map[string]interface{}{operation: path.String()})
 		return condition, nil
 	}
 }
@@ -313,11 +297,7 @@ func likeNotLike(conditionOperation conditionQueryOperation, fieldPath string, l
 		}
 	}
 
-	return func(condition *Condition) (*Condition, error) {
-		condition.tokens.PushRight(map[string]interface{}{
-			conditionQueryOperations[conditionOperation]: map[string]interface{}{fieldPath: queryLikeExpression}})
-		return condition, nil
-	}
+	return fieldCondition(conditionQueryOperations[conditionOperation], fieldPath, queryLikeExpression)
 }
 
 func (condition *Condition) parseQueue() error {
@@ -149,3 +149,19 @@ func TestNotLikeOperationsInvalid(t *testing.T) {
 	_, err := MakeCondition(NotLike("age", "00", "00", "00"), Close())
 	assert.Error(t, err)
 }
+
+func TestConditionFieldPaths(t *testing.T) {
+	condition, err := MakeCondition(And(), Equals("`name`", "Bob"), Exists("tags[]"), Close())
+	assert.Nil(t, err)
+	_, err = condition.Build()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"$and": []interface{}{
+		map[string]interface{}{"$eq": map[string]interface{}{"name": "Bob"}},
+		map[string]interface{}{"$exists": "tags[]"},
+	}}, condition.AsMap())
+
+	_, err = MakeCondition(Is("a[x]", GREATER, 1), Close())
+	assert.EqualError(t, err, `invalid field path "a[x]": invalid index "x" at position 2`)
+	_, err = MakeCondition(ElementAnd(""), Close())
+	assert.EqualError(t, err, "field path can't be empty")
+}
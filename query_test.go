@@ -152,3 +152,51 @@ func TestQuery_Offset(t *testing.T) {
 		assert.Equal(t, test.want.content, query.content)
 	}
 }
+
+func TestQuery_IndexHint(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []QueryOptions
+		want    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:    "use index",
+			options: []QueryOptions{UseIndex("idx_age", "idx_name"), Limit(5)},
+			want: map[string]interface{}{
+				"$options": map[string]interface{}{"ojai.mapr.query.hint-using-index": []string{"idx_age", "idx_name"}},
+				"$limit":   5,
+			},
+		},
+		{
+			name:    "forbid index",
+			options: []QueryOptions{UseIndex("idx_age"), ForbidIndex("idx_name")},
+			want: map[string]interface{}{"$options": map[string]interface{}{
+				"ojai.mapr.query.hint-using-index":     []string{"idx_age"},
+				"ojai.mapr.query.hint-not-using-index": []string{"idx_name"},
+			}},
+		},
+		{
+			name:    "forbid all indexes",
+			options: []QueryOptions{ForbidIndex()},
+			want:    map[string]interface{}{"$options": map[string]interface{}{"ojai.mapr.query.hint-using-index": "NONE"}},
+		},
+		{name: "use without names", options: []QueryOptions{UseIndex()},
+			wantErr: "index hint must contain at least one index name"},
+		{name: "empty name", options: []QueryOptions{ForbidIndex("")}, wantErr: "index name can't be empty"},
+		{name: "used and forbidden", options: []QueryOptions{ForbidIndex("idx"), UseIndex("idx")},
+			wantErr: "index idx can't be both used and forbidden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := MakeQuery(tt.options...)
+			if len(tt.wantErr) != 0 {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, query.content)
+			assert.Empty(t, query.CleanOptions().content["$options"])
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package private_maprdb_go_client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Name of index which is reported in query plan for scans of the table itself
+const primaryIndexName = "_id"
+
+// ScanType kind of data access performed by stream of query plan
+type ScanType int
+
+const (
+	// NoScan stream processes output of other streams, e.g. sorts or limits it
+	NoScan ScanType = iota
+	// TableScan stream reads documents from the table itself
+	TableScan
+	// IndexScan stream reads entries of secondary index
+	IndexScan
+	// Lookup stream reads documents of the table by _id of index entries
+	Lookup
+)
+
+var scanTypes = [...]string{
+	"none",
+	"tableScan",
+	"indexScan",
+	"lookup",
+}
+
+// String method returns name of the scan type
+func (scanType ScanType) String() string {
+	if scanType < 0 || int(scanType) >= len(scanTypes) {
+		return "unknown"
+	}
+	return scanTypes[scanType]
+}
+
+// QueryPlanNode stream of query plan
+// Name stream name reported by server, e.g. DBDocumentStream or RowkeyLookup
+// ScanType kind of data access performed by the stream
+// Table path of the primary table
+// Index name of the scanned index, "_id" for scans of the table itself
+// Filter condition pushed down to the stream, empty if documents aren't filtered
+// Projection field paths read by the stream, empty if whole documents are read
+// EstimatedRows number of rows the optimizer expects from the stream, -1 if it isn't reported
+// Parameters all parameters of the stream reported by server
+// Inputs streams which output is read by this stream
+type QueryPlanNode struct {
+	Name          string
+	ScanType      ScanType
+	Table         string
+	Index         string
+	Filter        string
+	Projection    []string
+	EstimatedRows int64
+	Parameters    map[string]interface{}
+	Inputs        []*QueryPlanNode
+}
+
+// QueryPlan query plan chosen by server for Find request.
+// Roots are the last streams of plan pipelines which produce the query result.
+type QueryPlan struct {
+	Roots []*QueryPlanNode
+}
+
+// queryPlanJson JSON encoded query plan, each pipeline is a list of streams
+// where every stream reads the output of the previous one
+type queryPlanJson struct {
+	QueryPlan [][]struct {
+		StreamName string                 `json:"streamName"`
+		Parameters map[string]interface{} `json:"parameters"`
+	} `json:"QueryPlan"`
+}
+
+// ParseQueryPlan decodes query plan returned by QueryResult.QueryPlan
+func ParseQueryPlan(jsonPlan string) (*QueryPlan, error) {
+	var decoded queryPlanJson
+	decoder := json.NewDecoder(strings.NewReader(jsonPlan))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("couldn't decode query plan: %v", err)
+	}
+	if decoded.QueryPlan == nil {
+		return nil, errors.New("query plan doesn't contain QueryPlan field")
+	}
+	plan := &QueryPlan{}
+	for _, pipeline := range decoded.QueryPlan {
+		var previous *QueryPlanNode
+		for _, stream := range pipeline {
+			node := makeQueryPlanNode(stream.StreamName, stream.Parameters)
+			if previous != nil {
+				node.Inputs = append(node.Inputs, previous)
+			}
+			previous = node
+		}
+		if previous != nil {
+			plan.Roots = append(plan.Roots, previous)
+		}
+	}
+	return plan, nil
+}
+
+// makeQueryPlanNode creates node of stream with known parameters decoded
+func makeQueryPlanNode(name string, parameters map[string]interface{}) *QueryPlanNode {
+	node := &QueryPlanNode{Name: name, EstimatedRows: -1, Parameters: parameters}
+	node.Table, _ = parameters["primaryTable"].(string)
+	node.Index, _ = parameters["indexName"].(string)
+	node.Filter, _ = parameters["condition"].(string)
+	if projection, ok := parameters["projectionPath"].([]interface{}); ok {
+		for _, fieldPath := range projection {
+			if fieldPath, ok := fieldPath.(string); ok {
+				node.Projection = append(node.Projection, fieldPath)
+			}
+		}
+	}
+	if rows, ok := parameters["estimatedRowCount"].(json.Number); ok {
+		if value, err := rows.Int64(); err == nil {
+			node.EstimatedRows = value
+		}
+	}
+	switch {
+	case strings.Contains(strings.ToLower(name), "lookup"):
+		node.ScanType = Lookup
+	case len(node.Index) != 0 && node.Index != primaryIndexName:
+		node.ScanType = IndexScan
+	case len(node.Table) != 0:
+		node.Index = primaryIndexName
+		node.ScanType = TableScan
+	}
+	return node
+}
+
+// Walk method calls fn for every node of the plan, inputs are visited after the node
+func (plan *QueryPlan) Walk(fn func(node *QueryPlanNode)) {
+	var walk func(node *QueryPlanNode)
+	walk = func(node *QueryPlanNode) {
+		fn(node)
+		for _, input := range node.Inputs {
+			walk(input)
+		}
+	}
+	for _, root := range plan.Roots {
+		walk(root)
+	}
+}
+
+// Indexes method returns names of secondary indexes scanned by the query
+func (plan *QueryPlan) Indexes() []string {
+	var indexes []string
+	plan.Walk(func(node *QueryPlanNode) {
+		if node.ScanType == IndexScan && !containsString(indexes, node.Index) {
+			indexes = append(indexes, node.Index)
+		}
+	})
+	return indexes
+}
+
+// UsesIndex method checks whether the query scans the named secondary index
+func (plan *QueryPlan) UsesIndex(indexName string) bool {
+	return containsString(plan.Indexes(), indexName)
+}
+
+// IsFullTableScan method checks whether the query reads the table itself instead of secondary indexes
+func (plan *QueryPlan) IsFullTableScan() bool {
+	tableScan := false
+	plan.Walk(func(node *QueryPlanNode) {
+		tableScan = tableScan || node.ScanType == TableScan
+	})
+	return tableScan
+}
+
+// String method returns the plan as indented tree, each node is followed by its inputs
+func (plan *QueryPlan) String() string {
+	builder := &strings.Builder{}
+	var write func(node *QueryPlanNode, depth int)
+	write = func(node *QueryPlanNode, depth int) {
+		builder.WriteString(strings.Repeat("  ", depth))
+		builder.WriteString(node.String())
+		builder.WriteString("\n")
+		for _, input := range node.Inputs {
+			write(input, depth+1)
+		}
+	}
+	for _, root := range plan.Roots {
+		write(root, 0)
+	}
+	return builder.String()
+}
+
+// String method returns name, scan type and decoded parameters of the node
+func (node *QueryPlanNode) String() string {
+	parts := []string{node.Name, node.ScanType.String()}
+	if len(node.Table) != 0 {
+		parts = append(parts, "table="+node.Table)
+	}
+	if node.ScanType == IndexScan {
+		parts = append(parts, "index="+node.Index)
+	}
+	if len(node.Filter) != 0 {
+		parts = append(parts, "filter="+node.Filter)
+	}
+	if len(node.Projection) != 0 {
+		parts = append(parts, "projection=["+strings.Join(node.Projection, ", ")+"]")
+	}
+	if node.EstimatedRows >= 0 {
+		parts = append(parts, fmt.Sprintf("rows=%v", node.EstimatedRows))
+	}
+	return strings.Join(parts, " ")
+}
+
+// ParsedQueryPlan method decodes the query plan, the plan must be requested with IncludeQueryPlan option
+func (queryResult *QueryResult) ParsedQueryPlan() (*QueryPlan, error) {
+	if len(queryResult.queryPlan) == 0 {
+		return nil, errors.New("query plan wasn't requested, use IncludeQueryPlan option of FindOptions")
+	}
+	return ParseQueryPlan(queryResult.queryPlan)
+}
+
+// QueryPlanIndexes method parses the query plan and returns names of secondary indexes chosen by the optimizer,
+// the list is empty if the query scans the table. The plan must be requested with IncludeQueryPlan option.
+func (queryResult *QueryResult) QueryPlanIndexes() ([]string, error) {
+	plan, err := queryResult.ParsedQueryPlan()
+	if err != nil {
+		return nil, err
+	}
+	return plan.Indexes(), nil
+}
@@ -0,0 +1,53 @@
+package private_maprdb_go_client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQueryPlan(t *testing.T) {
+	plan, err := ParseQueryPlan(`{"QueryPlan":[[` +
+		`{"streamName":"DBDocumentStream","parameters":{"queryConditionPath":true,"indexName":"idx_age",` +
+		`"projectionPath":["name","age"],"primaryTable":"/apps/users","condition":"(age > 30)","estimatedRowCount":120}},` +
+		`{"streamName":"RowkeyLookup","parameters":{"primaryTable":"/apps/users","indexName":"idx_age"}}]]}`)
+	assert.Nil(t, err)
+	assert.Len(t, plan.Roots, 1)
+	lookup := plan.Roots[0]
+	assert.Equal(t, Lookup, lookup.ScanType)
+	assert.Len(t, lookup.Inputs, 1)
+	scan := lookup.Inputs[0]
+	assert.Equal(t, &QueryPlanNode{
+		Name:          "DBDocumentStream",
+		ScanType:      IndexScan,
+		Table:         "/apps/users",
+		Index:         "idx_age",
+		Filter:        "(age > 30)",
+		Projection:    []string{"name", "age"},
+		EstimatedRows: 120,
+		Parameters:    scan.Parameters,
+	}, scan)
+	assert.True(t, plan.UsesIndex("idx_age"))
+	assert.False(t, plan.UsesIndex("idx_name"))
+	assert.False(t, plan.IsFullTableScan())
+	assert.Equal(t, "RowkeyLookup lookup table=/apps/users\n"+
+		"  DBDocumentStream indexScan table=/apps/users index=idx_age filter=(age > 30) "+
+		"projection=[name, age] rows=120\n", plan.String())
+
+	plan, err = ParseQueryPlan(`{"QueryPlan":[[{"streamName":"DBDocumentStream",` +
+		`"parameters":{"queryConditionPath":false,"projectionPath":[],"primaryTable":"/apps/users"}}]]}`)
+	assert.Nil(t, err)
+	assert.True(t, plan.IsFullTableScan())
+	assert.Empty(t, plan.Indexes())
+	assert.Equal(t, "DBDocumentStream tableScan table=/apps/users\n", plan.String())
+}
+
+func TestParseQueryPlan_Invalid(t *testing.T) {
+	for _, jsonPlan := range []string{"", "{", `{"plan":1}`, `{"QueryPlan":{}}`} {
+		_, err := ParseQueryPlan(jsonPlan)
+		assert.NotNil(t, err, jsonPlan)
+	}
+	_, err := (&QueryResult{}).ParsedQueryPlan()
+	assert.EqualError(t, err, "query plan wasn't requested, use IncludeQueryPlan option of FindOptions")
+	assert.Equal(t, "unknown", ScanType(7).String())
+}
@@ -1,81 +1,269 @@
 package private_maprdb_go_client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"gopkg.in/karalabe/cookiejar.v1/collections/deque"
 	"io"
+	"time"
 )
 
 // Result of Find RPC request
 type QueryResult struct {
+	stream           *DocumentStream
 	resultList       []interface{}
 	queryPlan        string
 	resultAsDocument bool
-	cache            *deque.Deque
 }
 
+// DocumentStream is a pull-based iterator over Find gRPC response stream.
+// Each call of Next decodes exactly one FindResponse, so the whole result never has to be kept in memory.
 type DocumentStream struct {
 	responseStream   MapRDbServer_FindClient
 	resultAsDocument bool
+	cancel           context.CancelFunc
+	recvTimeout      time.Duration
+	cache            *deque.Deque
+	current          *Document
+	err              error
+	exhausted        bool
+	closed           bool
+}
+
+// Result of a single Recv call on the response stream
+type recvResult struct {
+	element *FindResponse
+	err     error
 }
 
 // MakeQueryResult creates and returns new QueryResult for each Find gRPC request
 // responseStream gRPC response stream from server
 // findOptions options which were passed in Find request
 func MakeQueryResult(responseStream MapRDbServer_FindClient, findOptions *FindOptions) (*QueryResult, error) {
-	queryResult := &QueryResult{resultAsDocument: findOptions.ResultAsDocument}
+	return makeQueryResult(responseStream, findOptions, nil, 0)
+}
+
+// makeQueryResult reads the query plan (if requested) and the first document of the response stream,
+// so the errors returned by server before the first document are reported immediately.
+// The whole response stream is read only if FindOptions.Buffered is set.
+// cancel releases the context of the stream and is called when the stream is closed or buffered.
+// recvTimeout limits waiting for every response of the stream, the stream is canceled when it expires.
+func makeQueryResult(
+	responseStream MapRDbServer_FindClient,
+	findOptions *FindOptions,
+	cancel context.CancelFunc,
+	recvTimeout time.Duration,
+) (*QueryResult, error) {
+	queryResult := &QueryResult{
+		stream:           MakeDocumentStream(responseStream, findOptions.ResultAsDocument, cancel),
+		resultAsDocument: findOptions.ResultAsDocument,
+	}
+	queryResult.stream.recvTimeout = recvTimeout
 	if findOptions.IncludeQueryPlan {
-		element, err := responseStream.Recv()
+		element, err := queryResult.stream.recv()
 		if err == io.EOF {
+			queryResult.stream.Close()
 			return nil, fmt.Errorf("invalid response stream, according to input " +
 				"parameters query plan must be included into response stream")
 		}
+		if err == nil {
+			err = newOjaiError(element.GetError())
+		}
 		if err != nil {
-			return nil, err
+			queryResult.stream.Close()
+			return nil, wrapRpcError(err)
 		}
 		queryResult.queryPlan = element.GetJsonResponse()
 	}
-	resultList, err := queryResult.parseResponseStream(responseStream)
+	var err error
+	if findOptions.Buffered {
+		err = queryResult.stream.prefetchAll()
+	} else {
+		err = queryResult.stream.prefetch()
+	}
 	if err != nil {
+		queryResult.stream.Close()
 		return nil, err
 	}
-	queryResult.resultList = resultList
 	return queryResult, nil
 }
 
-// parse Find gRPC response stream and returns a slice of documents
-func (queryResult *QueryResult) parseResponseStream(responseStream MapRDbServer_FindClient) ([]interface{}, error) {
-	var resultList []interface{}
+// MakeDocumentStream creates and returns new DocumentStream over given Find gRPC response stream.
+// cancel is optional and releases the context of the stream when the DocumentStream is closed.
+func MakeDocumentStream(
+	responseStream MapRDbServer_FindClient,
+	resultAsDocument bool,
+	cancel context.CancelFunc,
+) *DocumentStream {
+	return &DocumentStream{
+		responseStream:   responseStream,
+		resultAsDocument: resultAsDocument,
+		cancel:           cancel,
+		cache:            deque.New(),
+	}
+}
+
+// prefetch decodes the next document and puts it into the stream cache.
+func (stream *DocumentStream) prefetch() error {
+	doc, err := stream.receive(nil)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		stream.err = err
+		return err
+	}
+	stream.cache.PushRight(doc)
+	return nil
+}
+
+// prefetchAll decodes all documents of the response stream into the stream cache
+// and releases the context of the stream, since it isn't read anymore.
+func (stream *DocumentStream) prefetchAll() error {
+	if stream.cancel != nil {
+		defer stream.cancel()
+	}
 	for {
-		element, err := responseStream.Recv()
+		doc, err := stream.receive(nil)
 		if err == io.EOF {
-			break
+			stream.exhausted = true
+			return nil
 		}
 		if err != nil {
-			return nil, err
+			stream.err = err
+			return err
 		}
-		if element.GetError().ErrCode != ErrorCode_NO_ERROR {
-			return nil, fmt.Errorf("unexpected error code recieved from server.\n %v.\n %v.\n %v\n",
-				element.GetError().ErrCode,
-				element.GetError().ErrorMessage,
-				element.GetError().JavaStackTrace)
-		}
-		doc, err := MakeDocument()
-		if err != nil {
-			return nil, err
-		}
-		err = json.Unmarshal([]byte(element.GetJsonResponse()), doc)
-		if err != nil {
-			return nil, err
-		}
-		if queryResult.resultAsDocument {
-			resultList = append(resultList, doc)
-		} else {
-			resultList = append(resultList, doc.AsMap())
+		stream.cache.PushRight(doc)
+	}
+}
+
+// Next decodes the next document from the response stream and returns true if it is available
+// through the Document method. Next returns false when the stream is exhausted, the stream is closed
+// or an error occurred. Err must be checked after Next returns false.
+func (stream *DocumentStream) Next() bool {
+	return stream.NextWithContext(nil)
+}
+
+// NextWithContext decodes the next document like Next, but it also returns false when ctx is done.
+// User defined context is required for this method.
+func (stream *DocumentStream) NextWithContext(ctx context.Context) bool {
+	if stream.closed || stream.err != nil {
+		stream.current = nil
+		return false
+	}
+	if !stream.cache.Empty() {
+		stream.current = stream.cache.PopLeft().(*Document)
+		return true
+	}
+	if stream.exhausted {
+		stream.current = nil
+		stream.Close()
+		return false
+	}
+	doc, err := stream.receive(ctx)
+	if err == io.EOF {
+		stream.current = nil
+		stream.Close()
+		return false
+	}
+	if err != nil {
+		stream.current = nil
+		stream.err = err
+		stream.Close()
+		return false
+	}
+	stream.current = doc
+	return true
+}
+
+// receive waits for the next FindResponse, checks its error code and decodes it into the Document.
+// The context is checked only if the stream can be canceled, since otherwise nothing can stop
+// the pending Recv and its goroutine would leak.
+func (stream *DocumentStream) receive(ctx context.Context) (*Document, error) {
+	var element *FindResponse
+	var err error
+	if ctx == nil || ctx.Done() == nil || stream.cancel == nil {
+		element, err = stream.recv()
+	} else {
+		resultChannel := make(chan recvResult, 1)
+		go func() {
+			element, err := stream.recv()
+			resultChannel <- recvResult{element: element, err: err}
+		}()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case result := <-resultChannel:
+			element, err = result.element, result.err
 		}
 	}
-	return resultList, nil
+	if err == io.EOF {
+		return nil, err
+	}
+	if err != nil {
+		return nil, wrapRpcError(err)
+	}
+	if element.GetError().GetErrCode() != ErrorCode_NO_ERROR {
+		return nil, newOjaiError(element.GetError())
+	}
+	doc, err := MakeDocument()
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal([]byte(element.GetJsonResponse()), doc)
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// recv waits for the next FindResponse. The stream is canceled if the response doesn't arrive in recvTimeout,
+// so a long scan isn't limited by a single deadline while every response is.
+func (stream *DocumentStream) recv() (*FindResponse, error) {
+	if stream.recvTimeout <= 0 || stream.cancel == nil {
+		return stream.responseStream.Recv()
+	}
+	timer := time.AfterFunc(stream.recvTimeout, stream.cancel)
+	element, err := stream.responseStream.Recv()
+	if !timer.Stop() {
+		return nil, context.DeadlineExceeded
+	}
+	return element, err
+}
+
+// Document returns the document decoded by the last successful call of Next or nil.
+func (stream *DocumentStream) Document() *Document {
+	return stream.current
+}
+
+// Value returns the document decoded by the last successful call of Next as Document instance
+// or as map[string]interface{} according to FindOptions.ResultAsDocument.
+func (stream *DocumentStream) Value() interface{} {
+	if stream.current == nil {
+		return nil
+	}
+	if stream.resultAsDocument {
+		return stream.current
+	}
+	return stream.current.AsMap()
+}
+
+// Err returns the first error occurred during the iteration or nil if the stream was read successfully.
+func (stream *DocumentStream) Err() error {
+	return stream.err
+}
+
+// Close stops the iteration and releases the response stream. Close can be called more than once.
+func (stream *DocumentStream) Close() {
+	if stream.closed {
+		return
+	}
+	stream.closed = true
+	stream.cache.Reset()
+	if stream.cancel != nil {
+		stream.cancel()
+	}
 }
 
 // QueryPlan method returns the query plan if the corresponding option was set in QueryOptions
@@ -83,7 +271,50 @@ func (queryResult *QueryResult) QueryPlan() string {
 	return queryResult.queryPlan
 }
 
-// DocumentList method returns slice of document which can be represent as map[string]interface{} or Document instances
+// Stream method returns DocumentStream of the QueryResult.
+func (queryResult *QueryResult) Stream() *DocumentStream {
+	return queryResult.stream
+}
+
+// Next method moves QueryResult to the next document, see DocumentStream.Next.
+func (queryResult *QueryResult) Next() bool {
+	return queryResult.stream.Next()
+}
+
+// NextWithContext method moves QueryResult to the next document, see DocumentStream.NextWithContext.
+// User defined context is required for this method.
+func (queryResult *QueryResult) NextWithContext(ctx context.Context) bool {
+	return queryResult.stream.NextWithContext(ctx)
+}
+
+// Document method returns the current document of the QueryResult, see DocumentStream.Document.
+func (queryResult *QueryResult) Document() *Document {
+	return queryResult.stream.Document()
+}
+
+// Err method returns the error occurred during the iteration, see DocumentStream.Err.
+func (queryResult *QueryResult) Err() error {
+	return queryResult.stream.Err()
+}
+
+// Close method releases the response stream of the QueryResult.
+func (queryResult *QueryResult) Close() {
+	queryResult.stream.Close()
+}
+
+// DocumentList method returns slice of document which can be represent as map[string]interface{} or Document instances.
+// DocumentList returns documents which were not consumed by Next yet. Errors of the result are returned
+// by the Find method only if FindOptions.Buffered is set, use ReadAll for streamed results.
 func (queryResult *QueryResult) DocumentList() []interface{} {
-	return queryResult.resultList
+	documents, _ := queryResult.ReadAll()
+	return documents
+}
+
+// ReadAll method reads all documents which were not consumed by Next yet and returns them together with
+// the error occurred during the iteration, so it keeps the whole result in memory.
+func (queryResult *QueryResult) ReadAll() ([]interface{}, error) {
+	for queryResult.stream.Next() {
+		queryResult.resultList = append(queryResult.resultList, queryResult.stream.Value())
+	}
+	return queryResult.resultList, queryResult.stream.Err()
 }
@@ -0,0 +1,179 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// findClientMock implements MapRDbServer_FindClient over predefined responses
+type findClientMock struct {
+	grpc.ClientStream
+	responses []*FindResponse
+	block     chan struct{}
+	received  int
+}
+
+func (client *findClientMock) Recv() (*FindResponse, error) {
+	if client.received == len(client.responses) {
+		if client.block != nil {
+			<-client.block
+		}
+		return nil, io.EOF
+	}
+	response := client.responses[client.received]
+	client.received++
+	return response, nil
+}
+
+func makeFindResponse(json string) *FindResponse {
+	return &FindResponse{
+		Error:           &RpcError{ErrCode: ErrorCode_NO_ERROR},
+		PayloadEncoding: PayloadEncoding_JSON_ENCODING,
+		Type:            FindResponseType_RESULT_DOCUMENT,
+		Data:            &FindResponse_JsonResponse{JsonResponse: json},
+	}
+}
+
+func TestQueryResult_Next(t *testing.T) {
+	stream := &findClientMock{responses: []*FindResponse{
+		makeFindResponse("{\"_id\":\"id1\",\"name\":\"Jhon\"}"),
+		makeFindResponse("{\"_id\":\"id2\",\"name\":\"Bob\"}"),
+	}}
+	queryResult, err := MakeQueryResult(stream, &FindOptions{ResultAsDocument: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stream.received)
+
+	var ids []string
+	for queryResult.Next() {
+		id, err := queryResult.Document().GetIdString()
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	assert.NoError(t, queryResult.Err())
+	assert.Equal(t, []string{"id1", "id2"}, ids)
+	assert.False(t, queryResult.Next())
+}
+
+func TestQueryResult_QueryPlan(t *testing.T) {
+	stream := &findClientMock{responses: []*FindResponse{
+		{Error: &RpcError{}, Type: FindResponseType_QUERY_PLAN, Data: &FindResponse_JsonResponse{JsonResponse: "{\"plan\":1}"}},
+		makeFindResponse("{\"_id\":\"id1\"}"),
+	}}
+	queryResult, err := MakeQueryResult(stream, &FindOptions{IncludeQueryPlan: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"plan\":1}", queryResult.QueryPlan())
+	assert.Equal(t, []interface{}{map[string]interface{}{"_id": "id1"}}, queryResult.DocumentList())
+}
+
+func TestQueryResult_ServerError(t *testing.T) {
+	stream := &findClientMock{responses: []*FindResponse{
+		{Error: &RpcError{ErrCode: ErrorCode_TABLE_NOT_FOUND, ErrorMessage: "table not found"}},
+	}}
+	_, err := MakeQueryResult(stream, &FindOptions{})
+	assert.Error(t, err)
+}
+
+func TestQueryResult_DocumentList(t *testing.T) {
+	stream := &findClientMock{responses: []*FindResponse{
+		makeFindResponse("{\"_id\":\"id1\"}"),
+		makeFindResponse("{\"_id\":\"id2\"}"),
+		makeFindResponse("{\"_id\":\"id3\"}"),
+	}}
+	queryResult, err := MakeQueryResult(stream, &FindOptions{})
+	assert.NoError(t, err)
+	assert.True(t, queryResult.Next())
+	assert.Len(t, queryResult.DocumentList(), 2)
+	assert.Len(t, queryResult.DocumentList(), 2)
+	assert.NoError(t, queryResult.Err())
+}
+
+func TestQueryResult_StreamError(t *testing.T) {
+	responses := func() *findClientMock {
+		return &findClientMock{responses: []*FindResponse{
+			makeFindResponse("{\"_id\":\"id1\"}"),
+			{Error: &RpcError{ErrCode: ErrorCode_TABLE_NOT_FOUND, ErrorMessage: "table not found"}},
+		}}
+	}
+	_, err := MakeQueryResult(responses(), &FindOptions{Buffered: true})
+	assert.Error(t, err)
+
+	queryResult, err := MakeQueryResult(responses(), &FindOptions{})
+	assert.NoError(t, err)
+	documents, err := queryResult.ReadAll()
+	assert.Error(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"_id": "id1"}}, documents)
+}
+
+func TestQueryResult_NextCanceled(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	stream := &findClientMock{responses: []*FindResponse{makeFindResponse("{\"_id\":\"id1\"}")}, block: block}
+	canceled := false
+	queryResult, err := makeQueryResult(stream, &FindOptions{}, func() { canceled = true }, 0)
+	assert.NoError(t, err)
+	assert.True(t, queryResult.Next())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, queryResult.NextWithContext(ctx))
+	assert.Equal(t, context.Canceled, queryResult.Err())
+	assert.True(t, canceled)
+}
+
+func TestQueryResult_Buffered(t *testing.T) {
+	stream := &findClientMock{responses: []*FindResponse{
+		makeFindResponse("{\"_id\":\"id1\"}"),
+		makeFindResponse("{\"_id\":\"id2\"}"),
+	}}
+	canceled := false
+	queryResult, err := makeQueryResult(stream, &FindOptions{Buffered: true}, func() { canceled = true }, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, stream.received)
+	assert.True(t, canceled)
+	documents, err := queryResult.ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, documents, 2)
+}
+
+func TestQueryResult_RecvTimeout(t *testing.T) {
+	block := make(chan struct{})
+	var once sync.Once
+	stream := &findClientMock{responses: []*FindResponse{makeFindResponse("{\"_id\":\"id1\"}")}, block: block}
+	queryResult, err := makeQueryResult(stream, &FindOptions{}, func() { once.Do(func() { close(block) }) },
+		10*time.Millisecond)
+	assert.NoError(t, err)
+	assert.True(t, queryResult.Next())
+	assert.False(t, queryResult.Next())
+	assert.Equal(t, context.DeadlineExceeded, queryResult.Err())
+}
+
+func TestQueryResult_QueryPlanIndexes(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    string
+		want    []string
+		wantErr bool
+	}{
+		{"table scan", `{"QueryPlan":[[{"streamName":"DBDocumentStream",` +
+			`"parameters":{"indexName":"_id","primaryTable":"/t"}}]]}`, nil, false},
+		{"index scan with lookup", `{"QueryPlan":[[{"streamName":"DBDocumentStream",` +
+			`"parameters":{"indexName":"idx_age","primaryTable":"/t"}},` +
+			`{"streamName":"RowkeyLookup","parameters":{"indexName":"idx_age","primaryTable":"/t"}}]]}`,
+			[]string{"idx_age"}, false},
+		{"not requested", "", nil, true},
+		{"invalid plan", "{", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indexes, err := (&QueryResult{queryPlan: tt.plan}).QueryPlanIndexes()
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, indexes)
+		})
+	}
+}
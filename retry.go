@@ -0,0 +1,263 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy parameters of retrying calls which failed with retryable gRPC codes.
+// MaxAttempts attempt count including the first one, 1 disables retries
+// InitialBackoff delay before the first retry
+// MaxBackoff maximum delay between attempts, unlimited if zero
+// Multiplier growth factor of delay after each retry, 1 keeps delay constant
+// Jitter randomization factor from 0 to 1, delay is chosen from [delay*(1-Jitter), delay*(1+Jitter)]
+// MaxElapsedTime no retry is made if it would start later than MaxElapsedTime after the first attempt, unlimited if zero
+// PerAttemptTimeout timeout of each unary attempt, attempt which exceeded it is retried, only call timeout is used if zero
+// RetryableCodes codes of failed calls which are retried
+// MethodRetryableCodes codes which are retried for particular method instead of RetryableCodes,
+// method is either short name, e.g. "FindById", or full gRPC method name
+// RetryNonIdempotent enables retries of INSERT requests and $increment, $decrement and $append mutations,
+// which may be applied twice if the failed attempt reached the server
+// OnRetry is called before each retry, e.g. for logging or metrics
+type RetryPolicy struct {
+	MaxAttempts          int
+	InitialBackoff       time.Duration
+	MaxBackoff           time.Duration
+	Multiplier           float64
+	Jitter               float64
+	MaxElapsedTime       time.Duration
+	PerAttemptTimeout    time.Duration
+	RetryableCodes       []codes.Code
+	MethodRetryableCodes map[string][]codes.Code
+	RetryNonIdempotent   bool
+	OnRetry              func(attempt RetryAttempt)
+	observer             func(ctx context.Context, attempt RetryAttempt)
+}
+
+// RetryAttempt describes failed attempt which is going to be retried
+// Method full gRPC method name
+// Attempt number of the failed attempt starting from 1
+// Err error of the failed attempt
+// Delay time before the next attempt
+type RetryAttempt struct {
+	Method  string
+	Attempt int
+	Err     error
+	Delay   time.Duration
+}
+
+// Mutation operations which change document depending on its current value
+var nonIdempotentMutations = []string{
+	mutationOperations[APPEND],
+	mutationOperations[INCREMENT],
+	mutationOperations[DECREMENT],
+}
+
+// DefaultRetryPolicy returns policy which is used by NewConnection if no other is set:
+// 9 attempts of calls failed with NotFound or Unavailable codes with exponential backoff
+// from 1 to 12 seconds with 20% jitter, retries stop after 60 seconds
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    defaultConnectionOpts.MaxAttempt,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Duration(defaultConnectionOpts.WaitBetweenSeconds) * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		MaxElapsedTime: time.Duration(defaultConnectionOpts.CallTimeoutSeconds) * time.Second,
+		RetryableCodes: []codes.Code{codes.NotFound, codes.Unavailable},
+	}
+}
+
+// linearRetryPolicy returns policy with constant delay which is used by WithRetry and ConnectionOptions
+func linearRetryPolicy(maxAttempt int, waitBetween time.Duration) *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:       maxAttempt,
+		InitialBackoff:    waitBetween,
+		MaxBackoff:        waitBetween,
+		Multiplier:        1,
+		PerAttemptTimeout: waitBetween,
+		RetryableCodes:    []codes.Code{codes.NotFound, codes.Unavailable},
+	}
+}
+
+// validate method checks policy parameters
+func (retryPolicy *RetryPolicy) validate() error {
+	switch {
+	case retryPolicy.MaxAttempts < 1:
+		return errors.New("retry attempt count must be positive")
+	case retryPolicy.InitialBackoff < 0 || retryPolicy.MaxBackoff < 0:
+		return errors.New("retry backoff can't be negative")
+	case retryPolicy.Multiplier < 1:
+		return errors.New("retry backoff multiplier must be at least 1")
+	case retryPolicy.Jitter < 0 || retryPolicy.Jitter > 1:
+		return errors.New("retry jitter must be between 0 and 1")
+	case retryPolicy.MaxElapsedTime < 0 || retryPolicy.PerAttemptTimeout < 0:
+		return errors.New("retry timeouts can't be negative")
+	}
+	return nil
+}
+
+// backoff method returns delay after given failed attempt
+func (retryPolicy *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(retryPolicy.InitialBackoff) * math.Pow(retryPolicy.Multiplier, float64(attempt-1))
+	if retryPolicy.MaxBackoff > 0 && delay > float64(retryPolicy.MaxBackoff) {
+		delay = float64(retryPolicy.MaxBackoff)
+	}
+	if retryPolicy.Jitter > 0 {
+		delay *= 1 + retryPolicy.Jitter*(2*rand.Float64()-1)
+	}
+	if delay > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// retryableCodes method returns codes which are retried for given method
+func (retryPolicy *RetryPolicy) retryableCodes(method string) []codes.Code {
+	if methodCodes, ok := retryPolicy.MethodRetryableCodes[method]; ok {
+		return methodCodes
+	}
+	if methodCodes, ok := retryPolicy.MethodRetryableCodes[method[strings.LastIndex(method, "/")+1:]]; ok {
+		return methodCodes
+	}
+	return retryPolicy.RetryableCodes
+}
+
+// isRetryableCode method checks whether failed attempt of method can be retried
+func (retryPolicy *RetryPolicy) isRetryableCode(method string, err error) bool {
+	code := status.Code(err)
+	for _, retryable := range retryPolicy.retryableCodes(method) {
+		if code == retryable {
+			return true
+		}
+	}
+	return false
+}
+
+// nextDelay method returns delay before the next attempt or false if failed attempt mustn't be retried
+// attemptTimedOut is true if attempt exceeded PerAttemptTimeout while the call context is still active
+func (retryPolicy *RetryPolicy) nextDelay(
+	ctx context.Context,
+	method string,
+	attempt int,
+	start time.Time,
+	err error,
+	attemptTimedOut bool,
+) (time.Duration, bool) {
+	if err == nil || attempt >= retryPolicy.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+	if !attemptTimedOut && !retryPolicy.isRetryableCode(method, err) {
+		return 0, false
+	}
+	delay := retryPolicy.backoff(attempt)
+	if retryPolicy.MaxElapsedTime > 0 && time.Since(start)+delay > retryPolicy.MaxElapsedTime {
+		return 0, false
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+		return 0, false
+	}
+	retryAttempt := RetryAttempt{Method: method, Attempt: attempt, Err: err, Delay: delay}
+	if retryPolicy.observer != nil {
+		retryPolicy.observer(ctx, retryAttempt)
+	}
+	if retryPolicy.OnRetry != nil {
+		retryPolicy.OnRetry(retryAttempt)
+	}
+	return delay, true
+}
+
+// isIdempotent checks whether request can be safely sent again after failure with unknown outcome
+func isIdempotent(req interface{}) bool {
+	switch request := req.(type) {
+	case *InsertOrReplaceRequest:
+		return request.GetInsertMode() != InsertMode_INSERT
+	case *UpdateRequest:
+		var mutation map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(request.GetJsonMutation()), &mutation); err != nil {
+			return false
+		}
+		for _, op := range nonIdempotentMutations {
+			if _, ok := mutation[op]; ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// sleepContext waits for delay and returns false if context is done earlier
+func sleepContext(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Closure function which returns UnaryClientInterceptor retrying calls according to RetryPolicy.
+// Non-idempotent requests are sent once unless RetryNonIdempotent is set.
+func UnaryClientRetryInterceptor(retryPolicy *RetryPolicy) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req interface{},
+		reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if !retryPolicy.RetryNonIdempotent && !isIdempotent(req) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		start := time.Now()
+		for attempt := 1; ; attempt++ {
+			attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+			if retryPolicy.PerAttemptTimeout > 0 {
+				attemptCtx, cancel = context.WithTimeout(ctx, retryPolicy.PerAttemptTimeout)
+			}
+			err := invoker(attemptCtx, method, req, reply, cc, opts...)
+			attemptTimedOut := attemptCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil
+			cancel()
+			delay, ok := retryPolicy.nextDelay(ctx, method, attempt, start, err, attemptTimedOut)
+			if !ok || !sleepContext(ctx, delay) {
+				return err
+			}
+		}
+	}
+}
+
+// Closure function which returns StreamClientInterceptor retrying opening of streams according to RetryPolicy.
+// Streams are opened without PerAttemptTimeout because the timeout would cancel returned stream,
+// errors received from opened stream aren't retried.
+func StreamClientRetryInterceptor(retryPolicy *RetryPolicy) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		start := time.Now()
+		for attempt := 1; ; attempt++ {
+			clientStream, err := streamer(ctx, desc, cc, method, opts...)
+			delay, ok := retryPolicy.nextDelay(ctx, method, attempt, start, err, false)
+			if !ok || !sleepContext(ctx, delay) {
+				return clientStream, err
+			}
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package private_maprdb_go_client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 10, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+	var delays []time.Duration
+	for attempt := 1; attempt <= 6; attempt++ {
+		delays = append(delays, policy.backoff(attempt))
+	}
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}, delays)
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(3)
+		assert.True(t, delay >= 200*time.Millisecond && delay <= 600*time.Millisecond, "delay %v", delay)
+	}
+	assert.Equal(t, time.Duration(0), (&RetryPolicy{Multiplier: 2}).backoff(100))
+}
+
+func TestIsIdempotent(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want bool
+	}{
+		{"find", &FindByIdRequest{}, true},
+		{"insert or replace", &InsertOrReplaceRequest{InsertMode: InsertMode_INSERT_OR_REPLACE}, true},
+		{"replace", &InsertOrReplaceRequest{InsertMode: InsertMode_REPLACE}, true},
+		{"insert", &InsertOrReplaceRequest{InsertMode: InsertMode_INSERT}, false},
+		{"set", updateRequest(`{"$set":{"a":1}}`), true},
+		{"increment", updateRequest(`{"$set":{"a":1},"$increment":{"b":1}}`), false},
+		{"decrement", updateRequest(`{"$decrement":{"b":1}}`), false},
+		{"append", updateRequest(`{"$append":{"c":[1]}}`), false},
+		{"invalid mutation", updateRequest(`{`), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isIdempotent(tt.req))
+		})
+	}
+}
+
+// updateRequest returns UpdateRequest with given JSON mutation
+func updateRequest(mutation string) *UpdateRequest {
+	return &UpdateRequest{Mutation: &UpdateRequest_JsonMutation{JsonMutation: mutation}}
+}
+
+// failingInvoker returns UnaryInvoker which fails with given errors and then succeeds
+func failingInvoker(calls *int, errs ...error) grpc.UnaryInvoker {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		*calls++
+		if *calls <= len(errs) {
+			return errs[*calls-1]
+		}
+		return nil
+	}
+}
+
+func TestUnaryClientRetryInterceptor(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	aborted := status.Error(codes.Aborted, "aborted")
+	basePolicy := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Multiplier:     2,
+		RetryableCodes: []codes.Code{codes.Unavailable},
+	}
+	tests := []struct {
+		name      string
+		configure func(policy *RetryPolicy)
+		method    string
+		req       interface{}
+		errs      []error
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{"read succeeds after retries", nil, "/com.mapr.data.db.MapRDbServer/FindById",
+			&FindByIdRequest{}, []error{unavailable, unavailable}, 3, codes.OK},
+		{"attempts exhausted", nil, "/com.mapr.data.db.MapRDbServer/FindById",
+			&FindByIdRequest{}, []error{unavailable, unavailable, unavailable}, 3, codes.Unavailable},
+		{"code isn't retryable", nil, "/com.mapr.data.db.MapRDbServer/FindById",
+			&FindByIdRequest{}, []error{aborted}, 1, codes.Aborted},
+		{"insert isn't retried", nil, "/com.mapr.data.db.MapRDbServer/InsertOrReplace",
+			&InsertOrReplaceRequest{InsertMode: InsertMode_INSERT}, []error{unavailable}, 1, codes.Unavailable},
+		{"increment isn't retried", nil, "/com.mapr.data.db.MapRDbServer/Update",
+			updateRequest(`{"$increment":{"a":1}}`), []error{unavailable}, 1, codes.Unavailable},
+		{"insert is retried if enabled", func(policy *RetryPolicy) { policy.RetryNonIdempotent = true },
+			"/com.mapr.data.db.MapRDbServer/InsertOrReplace",
+			&InsertOrReplaceRequest{InsertMode: InsertMode_INSERT}, []error{unavailable}, 2, codes.OK},
+		{"method codes by short name", func(policy *RetryPolicy) {
+			policy.MethodRetryableCodes = map[string][]codes.Code{"Update": {codes.Aborted}}
+		}, "/com.mapr.data.db.MapRDbServer/Update",
+			updateRequest(`{"$set":{"a":1}}`), []error{aborted, unavailable}, 2, codes.Unavailable},
+		{"method codes by full name", func(policy *RetryPolicy) {
+			policy.MethodRetryableCodes = map[string][]codes.Code{"/com.mapr.data.db.MapRDbServer/Ping": {}}
+		}, "/com.mapr.data.db.MapRDbServer/Ping", &PingRequest{}, []error{unavailable}, 1, codes.Unavailable},
+		{"max elapsed time", func(policy *RetryPolicy) {
+			policy.InitialBackoff = 20 * time.Millisecond
+			policy.MaxElapsedTime = 30 * time.Millisecond
+		}, "/com.mapr.data.db.MapRDbServer/FindById",
+			&FindByIdRequest{}, []error{unavailable, unavailable}, 2, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := basePolicy
+			if tt.configure != nil {
+				tt.configure(&policy)
+			}
+			calls := 0
+			err := UnaryClientRetryInterceptor(&policy)(context.Background(), tt.method, tt.req, nil, nil,
+				failingInvoker(&calls, tt.errs...))
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestUnaryClientRetryInterceptor_Attempts(t *testing.T) {
+	var attempts []RetryAttempt
+	policy := &RetryPolicy{
+		MaxAttempts:       4,
+		InitialBackoff:    time.Millisecond,
+		Multiplier:        1,
+		PerAttemptTimeout: 10 * time.Millisecond,
+		RetryableCodes:    []codes.Code{codes.NotFound},
+		OnRetry: func(attempt RetryAttempt) {
+			attempts = append(attempts, attempt)
+		},
+	}
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		if calls == 1 {
+			<-ctx.Done()
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if calls == 2 {
+			return status.Error(codes.NotFound, "not found")
+		}
+		return nil
+	}
+	err := UnaryClientRetryInterceptor(policy)(context.Background(), "/com.mapr.data.db.MapRDbServer/Ping",
+		&PingRequest{}, nil, nil, invoker)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Len(t, attempts, 2)
+	assert.Equal(t, "/com.mapr.data.db.MapRDbServer/Ping", attempts[0].Method)
+	assert.Equal(t, 1, attempts[0].Attempt)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(attempts[0].Err))
+	assert.Equal(t, 2, attempts[1].Attempt)
+	assert.Equal(t, codes.NotFound, status.Code(attempts[1].Err))
+	assert.Equal(t, time.Millisecond, attempts[1].Delay)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	policy.InitialBackoff = time.Hour
+	policy.OnRetry = func(RetryAttempt) { cancel() }
+	calls = 0
+	err = UnaryClientRetryInterceptor(policy)(ctx, "/com.mapr.data.db.MapRDbServer/Ping",
+		&PingRequest{}, nil, nil, failingInvoker(&calls, status.Error(codes.NotFound, "not found")))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestStreamClientRetryInterceptor(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, Multiplier: 1, RetryableCodes: []codes.Code{codes.Unavailable}}
+	calls := 0
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		calls++
+		if calls < 3 {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		}
+		return nil, status.Error(codes.Internal, "internal")
+	}
+	_, err := StreamClientRetryInterceptor(policy)(context.Background(), &grpc.StreamDesc{}, nil,
+		"/com.mapr.data.db.MapRDbServer/Find", streamer)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, 3, calls)
+}
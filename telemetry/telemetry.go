@@ -0,0 +1,303 @@
+// Package telemetry instruments calls of MapR Database connection with OpenTelemetry tracing and metrics.
+// It's a separate package, so applications which don't use OpenTelemetry don't depend on it.
+package telemetry
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	client "github.com/mapr/maprdb-go-client"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Name of the instrumentation scope of tracer and meter
+const instrumentationName = "github.com/mapr/maprdb-go-client"
+
+// Attributes of spans and metrics
+const (
+	rpcSystemKey     = attribute.Key("rpc.system")
+	rpcServiceKey    = attribute.Key("rpc.service")
+	rpcMethodKey     = attribute.Key("rpc.method")
+	rpcStatusCodeKey = attribute.Key("rpc.grpc.status_code")
+	dbSystemKey      = attribute.Key("db.system")
+	tablePathKey     = attribute.Key("maprdb.table_path")
+	insertModeKey    = attribute.Key("maprdb.insert_mode")
+	errorCodeKey     = attribute.Key("maprdb.error_code")
+	documentCountKey = attribute.Key("maprdb.document_count")
+	retryAttemptKey  = attribute.Key("maprdb.retry.attempt")
+	retryDelayKey    = attribute.Key("maprdb.retry.delay_ms")
+)
+
+// Options OpenTelemetry providers used for instrumentation of calls,
+// global providers are used if they are not set
+// TracerProvider creates span for every call with table path, method, insert mode,
+// error code and document count of Find stream
+// MeterProvider records maprdb.client.duration histogram, maprdb.client.retries
+// and maprdb.client.token_refreshes counters
+type Options struct {
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+}
+
+// Telemetry instruments of connection calls
+type Telemetry struct {
+	tracer         trace.Tracer
+	duration       metric.Float64Histogram
+	retries        metric.Int64Counter
+	tokenRefreshes metric.Int64Counter
+}
+
+// MakeTelemetry creates tracer and metric instruments from Options
+func MakeTelemetry(options *Options) (*Telemetry, error) {
+	if options == nil {
+		options = &Options{}
+	}
+	tracerProvider, meterProvider := options.TracerProvider, options.MeterProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+	meter := meterProvider.Meter(instrumentationName)
+	duration, err := meter.Float64Histogram("maprdb.client.duration",
+		metric.WithDescription("Duration of MapR Database calls"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+	retries, err := meter.Int64Counter("maprdb.client.retries",
+		metric.WithDescription("Number of retried attempts of MapR Database calls"))
+	if err != nil {
+		return nil, err
+	}
+	tokenRefreshes, err := meter.Int64Counter("maprdb.client.token_refreshes",
+		metric.WithDescription("Number of authentication tokens issued by server"))
+	if err != nil {
+		return nil, err
+	}
+	return &Telemetry{
+		tracer:         tracerProvider.Tracer(instrumentationName),
+		duration:       duration,
+		retries:        retries,
+		tokenRefreshes: tokenRefreshes,
+	}, nil
+}
+
+// ConnectionOptions method returns options of client.NewConnection which trace and measure its calls,
+// e.g. client.NewConnection(ctx, target, telemetry.ConnectionOptions()...)
+func (telemetry *Telemetry) ConnectionOptions() []client.ConnectionOption {
+	return []client.ConnectionOption{
+		client.WithUnaryInterceptors(telemetry.unaryInterceptor()),
+		client.WithStreamInterceptors(telemetry.streamInterceptor()),
+		client.WithRetryObserver(telemetry.retried),
+		client.WithAuthObserver(telemetry.authorized),
+	}
+}
+
+// methodAttributes returns attributes of full gRPC method name
+func methodAttributes(method string) []attribute.KeyValue {
+	method = strings.TrimPrefix(method, "/")
+	service, name := "", method
+	if index := strings.LastIndex(method, "/"); index >= 0 {
+		service, name = method[:index], method[index+1:]
+	}
+	return []attribute.KeyValue{rpcSystemKey.String("grpc"), rpcServiceKey.String(service), rpcMethodKey.String(name)}
+}
+
+// requestAttributes returns attributes of request, e.g. table path
+func requestAttributes(req interface{}) []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+	if request, ok := req.(interface{ GetTablePath() string }); ok && len(request.GetTablePath()) != 0 {
+		attributes = append(attributes, tablePathKey.String(request.GetTablePath()))
+	}
+	if request, ok := req.(*client.InsertOrReplaceRequest); ok {
+		attributes = append(attributes, insertModeKey.String(request.GetInsertMode().String()))
+	}
+	return attributes
+}
+
+// startSpan method starts client span of call
+func (telemetry *Telemetry) startSpan(ctx context.Context, method string, req interface{}) (context.Context, trace.Span) {
+	attributes := append(methodAttributes(method), dbSystemKey.String("maprdb"))
+	return telemetry.tracer.Start(ctx, strings.TrimPrefix(method, "/"),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(append(attributes, requestAttributes(req)...)...))
+}
+
+// finish method ends span of call and records its duration
+func (telemetry *Telemetry) finish(
+	ctx context.Context,
+	span trace.Span,
+	method string,
+	start time.Time,
+	err error,
+	attributes ...attribute.KeyValue,
+) {
+	code := client.ErrorCodeOf(err)
+	attributes = append(attributes, errorCodeKey.String(code.String()))
+	if err != nil {
+		attributes = append(attributes, rpcStatusCodeKey.Int(int(status.Code(err))))
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.SetAttributes(attributes...)
+	span.End()
+	telemetry.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		append(methodAttributes(method), errorCodeKey.String(code.String()))...))
+}
+
+// retried method records retry of failed attempt in the span of call
+func (telemetry *Telemetry) retried(ctx context.Context, attempt client.RetryAttempt) {
+	code := status.Code(attempt.Err)
+	trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+		retryAttemptKey.Int(attempt.Attempt),
+		retryDelayKey.Int64(attempt.Delay.Milliseconds()),
+		rpcStatusCodeKey.Int(int(code))))
+	telemetry.retries.Add(ctx, 1, metric.WithAttributes(
+		append(methodAttributes(attempt.Method), rpcStatusCodeKey.Int(int(code)))...))
+}
+
+// unaryInterceptor method returns interceptor which traces and measures unary calls
+func (telemetry *Telemetry) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req interface{},
+		reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+		ctx, span := telemetry.startSpan(ctx, method, req)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		telemetry.finish(ctx, span, method, start, client.CallError(err, reply))
+		return err
+	}
+}
+
+// streamInterceptor method returns interceptor which traces and measures stream calls,
+// span is finished when the stream is read to the end, fails or its context is done
+func (telemetry *Telemetry) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		start := time.Now()
+		ctx, span := telemetry.startSpan(ctx, method, nil)
+		clientStream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			telemetry.finish(ctx, span, method, start, client.CallError(err, nil))
+			return nil, err
+		}
+		tracedStream := &tracedClientStream{
+			ClientStream: clientStream,
+			telemetry:    telemetry,
+			ctx:          ctx,
+			span:         span,
+			method:       method,
+			start:        start,
+			done:         make(chan struct{}),
+		}
+		// Span of stream which is read to the end or failed is finished by RecvMsg,
+		// span of canceled stream is finished here unless context can't be canceled
+		if ctx.Done() != nil {
+			go func() {
+				select {
+				case <-ctx.Done():
+					tracedStream.finish(status.FromContextError(ctx.Err()).Err())
+				case <-tracedStream.done:
+				}
+			}()
+		}
+		return tracedStream, nil
+	}
+}
+
+// tracedClientStream counts documents received from Find stream and finishes its span
+type tracedClientStream struct {
+	grpc.ClientStream
+	telemetry *Telemetry
+	ctx       context.Context
+	span      trace.Span
+	method    string
+	start     time.Time
+	mutex     sync.Mutex
+	documents int64
+	finished  bool
+	done      chan struct{}
+}
+
+// SendMsg method adds attributes of request to the span
+func (tracedStream *tracedClientStream) SendMsg(m interface{}) error {
+	tracedStream.span.SetAttributes(requestAttributes(m)...)
+	err := tracedStream.ClientStream.SendMsg(m)
+	if err != nil && err != io.EOF {
+		tracedStream.finish(client.CallError(err, nil))
+	}
+	return err
+}
+
+// RecvMsg method counts received documents and change events and finishes the span at the end of stream
+func (tracedStream *tracedClientStream) RecvMsg(m interface{}) error {
+	err := tracedStream.ClientStream.RecvMsg(m)
+	if err == io.EOF {
+		tracedStream.finish(nil)
+		return err
+	}
+	if err != nil {
+		tracedStream.finish(client.CallError(err, nil))
+		return err
+	}
+	document := false
+	switch response := m.(type) {
+	case *client.FindResponse:
+		document = response.GetType() == client.FindResponseType_RESULT_DOCUMENT
+	case *client.ChangesResponse:
+		document = true
+	}
+	if err := client.CallError(nil, m); err != nil {
+		tracedStream.finish(err)
+	} else if document {
+		tracedStream.mutex.Lock()
+		tracedStream.documents++
+		tracedStream.mutex.Unlock()
+	}
+	return nil
+}
+
+// finish method finishes the span once with the first error or end of stream
+func (tracedStream *tracedClientStream) finish(err error) {
+	tracedStream.mutex.Lock()
+	if tracedStream.finished {
+		tracedStream.mutex.Unlock()
+		return
+	}
+	tracedStream.finished = true
+	documents := tracedStream.documents
+	tracedStream.mutex.Unlock()
+	close(tracedStream.done)
+	tracedStream.telemetry.finish(tracedStream.ctx, tracedStream.span, tracedStream.method, tracedStream.start,
+		err, documentCountKey.Int64(documents))
+}
+
+// authorized method records token refresh if server returned new token
+func (telemetry *Telemetry) authorized(authorization string, header metadata.MD, err error) {
+	if tokens := header.Get("bearer-token"); err == nil && len(tokens) != 0 && "bearer "+tokens[0] != authorization {
+		telemetry.tokenRefreshes.Add(context.Background(), 1)
+	}
+}
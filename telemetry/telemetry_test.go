@@ -0,0 +1,143 @@
+package telemetry
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	client "github.com/mapr/maprdb-go-client"
+	"github.com/stretchr/testify/assert"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// makeTestTelemetry returns telemetry which records spans and metrics in memory
+func makeTestTelemetry(t *testing.T) (*Telemetry, *tracetest.InMemoryExporter, *sdkmetric.ManualReader) {
+	exporter := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+	telemetry, err := MakeTelemetry(&Options{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)),
+		MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	})
+	assert.Nil(t, err)
+	return telemetry, exporter, reader
+}
+
+// counterValue returns sum of all data points of the counter
+func counterValue(t *testing.T, reader *sdkmetric.ManualReader, name string) int64 {
+	var metrics metricdata.ResourceMetrics
+	assert.Nil(t, reader.Collect(context.Background(), &metrics))
+	var value int64
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name == name {
+				for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+					value += point.Value
+				}
+			}
+		}
+	}
+	return value
+}
+
+func TestTelemetry_Retries(t *testing.T) {
+	telemetry, exporter, reader := makeTestTelemetry(t)
+	calls := 0
+	err := telemetry.unaryInterceptor()(context.Background(), "/com.mapr.data.db.MapRDbServer/FindById",
+		&client.FindByIdRequest{TablePath: "/t"}, &client.FindByIdResponse{}, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			for calls = 1; calls < 3; calls++ {
+				telemetry.retried(ctx, client.RetryAttempt{
+					Method:  method,
+					Attempt: calls,
+					Err:     status.Error(codes.Unavailable, "unavailable"),
+					Delay:   time.Millisecond,
+				})
+			}
+			return status.Error(codes.Unavailable, "unavailable")
+		})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 3, calls)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Len(t, spans[0].Events, 2)
+	assert.Equal(t, "retry", spans[0].Events[0].Name)
+	assert.Equal(t, otelcodes.Error, spans[0].Status.Code)
+	assert.Equal(t, int64(2), counterValue(t, reader, "maprdb.client.retries"))
+}
+
+func TestTelemetry_TokenRefreshes(t *testing.T) {
+	telemetry, _, reader := makeTestTelemetry(t)
+	telemetry.authorized("basic dXNlcjpwYXNz", metadata.Pairs("bearer-token", "first"), nil)
+	telemetry.authorized("bearer first", metadata.Pairs("bearer-token", "first"), nil)
+	telemetry.authorized("bearer first", metadata.Pairs("bearer-token", "second"), nil)
+	telemetry.authorized("bearer second", nil, status.Error(codes.Unauthenticated, "token expired"))
+	assert.Equal(t, int64(2), counterValue(t, reader, "maprdb.client.token_refreshes"))
+}
+
+// fakeFindStream returns FindResponse messages and then io.EOF
+type fakeFindStream struct {
+	grpc.ClientStream
+	responses []*client.FindResponse
+}
+
+func (stream *fakeFindStream) SendMsg(m interface{}) error {
+	return nil
+}
+
+func (stream *fakeFindStream) RecvMsg(m interface{}) error {
+	if len(stream.responses) == 0 {
+		return io.EOF
+	}
+	*m.(*client.FindResponse) = client.FindResponse{
+		Error: stream.responses[0].GetError(),
+		Type:  stream.responses[0].GetType(),
+	}
+	stream.responses = stream.responses[1:]
+	return nil
+}
+
+func TestTelemetry_Stream(t *testing.T) {
+	telemetry, exporter, _ := makeTestTelemetry(t)
+	open := func(responses ...*client.FindResponse) {
+		clientStream, err := telemetry.streamInterceptor()(context.Background(), &grpc.StreamDesc{}, nil,
+			"/com.mapr.data.db.MapRDbServer/Find",
+			func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				return &fakeFindStream{responses: responses}, nil
+			})
+		assert.Nil(t, err)
+		assert.Nil(t, clientStream.SendMsg(&client.FindRequest{TablePath: "/t"}))
+		for clientStream.RecvMsg(&client.FindResponse{}) == nil {
+		}
+		select {
+		case <-clientStream.(*tracedClientStream).done:
+		default:
+			t.Error("span of the stream isn't finished")
+		}
+	}
+	open(&client.FindResponse{Type: client.FindResponseType_QUERY_PLAN},
+		&client.FindResponse{Type: client.FindResponseType_RESULT_DOCUMENT},
+		&client.FindResponse{Type: client.FindResponseType_RESULT_DOCUMENT},
+		&client.FindResponse{Type: client.FindResponseType_RESULT_DOCUMENT})
+	open(&client.FindResponse{Error: &client.RpcError{ErrCode: client.ErrorCode_TABLE_NOT_FOUND}})
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	values := map[string]interface{}{}
+	for _, kv := range spans[0].Attributes {
+		values[string(kv.Key)] = kv.Value.AsInterface()
+	}
+	assert.Equal(t, "/t", values["maprdb.table_path"])
+	assert.Equal(t, int64(3), values["maprdb.document_count"])
+	assert.Equal(t, "NO_ERROR", values["maprdb.error_code"])
+	assert.Equal(t, otelcodes.Error, spans[1].Status.Code)
+}
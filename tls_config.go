@@ -0,0 +1,226 @@
+package private_maprdb_go_client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// TLSOptions TLS parameters of the connection, TLS is enabled if options are set even without ssl=true in connection string
+// Config custom tls.Config, it is cloned and other parameters are applied to the clone
+// CAPem PEM encoded certificates of cluster CA which are trusted in addition to sslCA and sslCAPem parameters
+// CertPem, KeyPem PEM encoded client certificate and private key for mutual TLS
+// UseSystemRoots trusts system root certificates together with cluster CA
+// ReloadCertificates reads sslCA, sslCert and sslKey files again on handshake if they were modified,
+// so rotated certificates are used by new connections of long-running services
+type TLSOptions struct {
+	Config             *tls.Config
+	CAPem              []byte
+	CertPem            []byte
+	KeyPem             []byte
+	UseSystemRoots     bool
+	ReloadCertificates bool
+}
+
+// SSL parameters of connection string
+// ca, cert and key are paths to PEM files, caPem is PEM encoded CA certificate
+type sslParameters struct {
+	ssl                bool
+	validate           bool
+	ca                 string
+	caPem              string
+	cert               string
+	key                string
+	targetNameOverride string
+}
+
+// certificateLoader loads CA pool and client certificate and reloads them if files were modified.
+// loaded is true after the first successful load.
+// All fields except files and PEM content are guarded by mutex.
+type certificateLoader struct {
+	caFile      string
+	certFile    string
+	keyFile     string
+	caPem       []byte
+	systemRoots bool
+	mutex       sync.Mutex
+	loaded      bool
+	modTimes    []time.Time
+	pool        *x509.CertPool
+	certificate *tls.Certificate
+}
+
+// makeTLSConfig returns tls.Config for connection string SSL parameters and TLSOptions
+func makeTLSConfig(params *sslParameters, options *TLSOptions) (*tls.Config, error) {
+	if options == nil {
+		options = &TLSOptions{}
+	}
+	if (len(params.cert) == 0) != (len(params.key) == 0) {
+		return nil, errors.New("'sslCert' and 'sslKey' parameters must be set together")
+	}
+	if (len(options.CertPem) == 0) != (len(options.KeyPem) == 0) {
+		return nil, errors.New("client certificate and key PEM must be set together")
+	}
+	config := &tls.Config{}
+	if options.Config != nil {
+		config = options.Config.Clone()
+	}
+	if len(params.targetNameOverride) != 0 {
+		config.ServerName = params.targetNameOverride
+	}
+	if len(options.CertPem) != 0 {
+		certificate, err := tls.X509KeyPair(options.CertPem, options.KeyPem)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+	loader := &certificateLoader{
+		caFile:      params.ca,
+		certFile:    params.cert,
+		keyFile:     params.key,
+		caPem:       append([]byte(params.caPem), options.CAPem...),
+		systemRoots: options.UseSystemRoots,
+	}
+	if err := loader.reload(); err != nil {
+		return nil, err
+	}
+	if loader.pool != nil {
+		config.RootCAs = loader.pool
+	}
+	if loader.certificate != nil {
+		config.Certificates = []tls.Certificate{*loader.certificate}
+	}
+	if !params.validate {
+		config.InsecureSkipVerify = true
+	}
+	if !options.ReloadCertificates {
+		return config, nil
+	}
+	if len(loader.certFile) != 0 {
+		config.Certificates = nil
+		config.GetClientCertificate = loader.getClientCertificate
+	}
+	if len(loader.caFile) != 0 && params.validate {
+		// server certificate is verified by VerifyConnection with the current CA pool
+		config.InsecureSkipVerify = true
+		config.VerifyConnection = loader.verifyConnection
+	}
+	return config, nil
+}
+
+// getClientCertificate method returns client certificate which is reloaded if files were modified
+func (loader *certificateLoader) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	loader.mutex.Lock()
+	defer loader.mutex.Unlock()
+	if err := loader.reload(); err != nil && loader.certificate == nil {
+		return nil, err
+	}
+	return loader.certificate, nil
+}
+
+// verifyConnection method verifies server certificate chain with CA pool which is reloaded if files were modified
+func (loader *certificateLoader) verifyConnection(state tls.ConnectionState) error {
+	loader.mutex.Lock()
+	if err := loader.reload(); err != nil && loader.pool == nil {
+		loader.mutex.Unlock()
+		return err
+	}
+	pool := loader.pool
+	loader.mutex.Unlock()
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server didn't provide certificate")
+	}
+	verifyOptions := x509.VerifyOptions{
+		DNSName:       state.ServerName,
+		Roots:         pool,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, certificate := range state.PeerCertificates[1:] {
+		verifyOptions.Intermediates.AddCert(certificate)
+	}
+	_, err := state.PeerCertificates[0].Verify(verifyOptions)
+	return err
+}
+
+// reload method loads certificates if it's the first call or files were modified since the last load.
+// Previously loaded certificates are kept if files can't be loaded, e.g. during rotation.
+func (loader *certificateLoader) reload() error {
+	var modTimes []time.Time
+	for _, file := range []string{loader.caFile, loader.certFile, loader.keyFile} {
+		if len(file) == 0 {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	if loader.loaded && equalTimes(loader.modTimes, modTimes) {
+		return nil
+	}
+	pool, err := loader.loadPool()
+	if err != nil {
+		return err
+	}
+	var certificate *tls.Certificate
+	if len(loader.certFile) != 0 {
+		loaded, err := tls.LoadX509KeyPair(loader.certFile, loader.keyFile)
+		if err != nil {
+			return err
+		}
+		certificate = &loaded
+	}
+	loader.pool = pool
+	loader.certificate = certificate
+	loader.modTimes = modTimes
+	loader.loaded = true
+	return nil
+}
+
+// loadPool method returns CA pool or nil if no CA is configured, so default system pool is used
+func (loader *certificateLoader) loadPool() (*x509.CertPool, error) {
+	if len(loader.caFile) == 0 && len(loader.caPem) == 0 && !loader.systemRoots {
+		return nil, nil
+	}
+	pool := x509.NewCertPool()
+	if loader.systemRoots {
+		systemPool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, err
+		}
+		pool = systemPool
+	}
+	if len(loader.caFile) != 0 {
+		content, err := ioutil.ReadFile(loader.caFile)
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(content) {
+			return nil, fmt.Errorf("failed to append certificates from %v", loader.caFile)
+		}
+	}
+	if len(loader.caPem) != 0 && !pool.AppendCertsFromPEM(loader.caPem) {
+		return nil, errors.New("failed to append certificates from CA PEM")
+	}
+	return pool, nil
+}
+
+// equalTimes checks whether slices contain the same times
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
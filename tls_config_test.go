@@ -0,0 +1,218 @@
+package private_maprdb_go_client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certPem     []byte
+	keyPem      []byte
+}
+
+func makeTestCertificate(t *testing.T, name string, parent *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.certificate, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	assert.Nil(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	return &testCertificate{
+		certificate: certificate,
+		key:         key,
+		certPem:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPem:      pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+}
+
+// handshake connects client with config to mutual TLS server and returns common name of client certificate
+func handshake(t *testing.T, ca, server *testCertificate, config *tls.Config) (string, error) {
+	serverCertificate, err := tls.X509KeyPair(server.certPem, server.keyPem)
+	assert.Nil(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.certificate)
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	assert.Nil(t, serverConn.SetDeadline(deadline))
+	assert.Nil(t, clientConn.SetDeadline(deadline))
+	config = config.Clone()
+	config.ServerName = server.certificate.Subject.CommonName
+	tlsServer := tls.Server(serverConn, &tls.Config{
+		Certificates: []tls.Certificate{serverCertificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	})
+	serverResult := make(chan string, 1)
+	go func() {
+		if tlsServer.Handshake() != nil || len(tlsServer.ConnectionState().PeerCertificates) == 0 {
+			serverResult <- ""
+			serverConn.Close()
+			return
+		}
+		serverResult <- tlsServer.ConnectionState().PeerCertificates[0].Subject.CommonName
+	}()
+	tlsClient := tls.Client(clientConn, config)
+	err = tlsClient.Handshake()
+	if err != nil {
+		clientConn.Close()
+		<-serverResult
+		return "", err
+	}
+	// client certificate is verified by server after client handshake is finished in TLS 1.3
+	go ioutil.ReadAll(tlsClient)
+	name := <-serverResult
+	if len(name) == 0 {
+		return "", errors.New("client certificate is rejected")
+	}
+	return name, nil
+}
+
+func writeTestFile(t *testing.T, path string, content []byte, modTime time.Time) {
+	assert.Nil(t, ioutil.WriteFile(path, content, 0600))
+	assert.Nil(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestMakeTLSConfig(t *testing.T) {
+	ca := makeTestCertificate(t, "ca", nil)
+	server := makeTestCertificate(t, "maprdb.local", ca)
+	client := makeTestCertificate(t, "client", ca)
+	otherCa := makeTestCertificate(t, "other", nil)
+	dir, err := ioutil.TempDir("", "maprdb-tls")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	caPath, certPath, keyPath := filepath.Join(dir, "ca.pem"), filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key")
+	now := time.Now()
+	writeTestFile(t, caPath, ca.certPem, now)
+	writeTestFile(t, certPath, client.certPem, now)
+	writeTestFile(t, keyPath, client.keyPem, now)
+
+	config, err := makeTLSConfig(&sslParameters{ssl: true, validate: true, ca: caPath, cert: certPath, key: keyPath}, nil)
+	assert.Nil(t, err)
+	name, err := handshake(t, ca, server, config)
+	assert.Nil(t, err)
+	assert.Equal(t, "client", name)
+
+	config, err = makeTLSConfig(&sslParameters{ssl: true, validate: true, caPem: string(ca.certPem)},
+		&TLSOptions{CertPem: client.certPem, KeyPem: client.keyPem, Config: &tls.Config{MinVersion: tls.VersionTLS12}})
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+	name, err = handshake(t, ca, server, config)
+	assert.Nil(t, err)
+	assert.Equal(t, "client", name)
+
+	config, err = makeTLSConfig(&sslParameters{ssl: true, validate: true, caPem: string(otherCa.certPem)},
+		&TLSOptions{CertPem: client.certPem, KeyPem: client.keyPem})
+	assert.Nil(t, err)
+	_, err = handshake(t, ca, server, config)
+	assert.NotNil(t, err)
+
+	config, err = makeTLSConfig(&sslParameters{ssl: true, validate: false, cert: certPath, key: keyPath}, nil)
+	assert.Nil(t, err)
+	name, err = handshake(t, ca, makeTestCertificate(t, "maprdb.local", otherCa), config)
+	assert.Nil(t, err)
+	assert.Equal(t, "client", name)
+
+	_, err = makeTLSConfig(&sslParameters{ssl: true, validate: true, cert: certPath}, nil)
+	assert.NotNil(t, err)
+	_, err = makeTLSConfig(&sslParameters{ssl: true, validate: true}, &TLSOptions{CertPem: client.certPem})
+	assert.NotNil(t, err)
+	_, err = makeTLSConfig(&sslParameters{ssl: true, validate: true, caPem: "not a certificate"}, nil)
+	assert.NotNil(t, err)
+	_, err = makeTLSConfig(&sslParameters{ssl: true, validate: true, ca: filepath.Join(dir, "missing.pem")}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestMakeTLSConfig_Reload(t *testing.T) {
+	ca := makeTestCertificate(t, "ca", nil)
+	server := makeTestCertificate(t, "maprdb.local", ca)
+	client := makeTestCertificate(t, "client", ca)
+	rotatedCa := makeTestCertificate(t, "rotated-ca", nil)
+	rotatedServer := makeTestCertificate(t, "maprdb.local", rotatedCa)
+	rotatedClient := makeTestCertificate(t, "rotated-client", rotatedCa)
+	dir, err := ioutil.TempDir("", "maprdb-tls")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	caPath, certPath, keyPath := filepath.Join(dir, "ca.pem"), filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key")
+	now := time.Now()
+	writeTestFile(t, caPath, ca.certPem, now)
+	writeTestFile(t, certPath, client.certPem, now)
+	writeTestFile(t, keyPath, client.keyPem, now)
+
+	params := &sslParameters{ssl: true, validate: true, ca: caPath, cert: certPath, key: keyPath}
+	config, err := makeTLSConfig(params, &TLSOptions{ReloadCertificates: true})
+	assert.Nil(t, err)
+	name, err := handshake(t, ca, server, config)
+	assert.Nil(t, err)
+	assert.Equal(t, "client", name)
+
+	// certificate without matching key is ignored until key is rotated too
+	rotated := now.Add(time.Minute)
+	writeTestFile(t, certPath, rotatedClient.certPem, rotated)
+	name, err = handshake(t, ca, server, config)
+	assert.Nil(t, err)
+	assert.Equal(t, "client", name)
+
+	writeTestFile(t, keyPath, rotatedClient.keyPem, rotated)
+	writeTestFile(t, caPath, rotatedCa.certPem, rotated)
+	name, err = handshake(t, rotatedCa, rotatedServer, config)
+	assert.Nil(t, err)
+	assert.Equal(t, "rotated-client", name)
+	_, err = handshake(t, rotatedCa, server, config)
+	assert.NotNil(t, err)
+}
+
+func TestParseConnectionString_SSL(t *testing.T) {
+	ca := makeTestCertificate(t, "ca", nil)
+	config, err := parseConnectionString("localhost:5678?auth=basic;user=mapr;password=mapr;ssl=true;" +
+		"sslCert=/etc/client.pem;sslKey=/etc/client.key;sslCAPem=" + url.QueryEscape(string(ca.certPem)))
+	assert.Nil(t, err)
+	assert.Equal(t, &sslParameters{
+		ssl:      true,
+		validate: true,
+		caPem:    string(ca.certPem),
+		cert:     "/etc/client.pem",
+		key:      "/etc/client.key",
+	}, config.ssl)
+
+	_, err = parseConnectionString("localhost:5678?auth=basic;user=mapr;password=mapr;" +
+		"sslCert=/etc/client.pem;sslKey=/etc/client.key")
+	assert.NotNil(t, err)
+}